@@ -0,0 +1,65 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+	"github.com/ovirt/terraform-provider-ovirt/v2/internal/ovirt"
+)
+
+// connectionFlags are the command line flags subcommands use to connect to the oVirt engine. Settings not passed on
+// the command line are taken from the same environment variables and config file profile as the provider options.
+// The password is only read from the OVIRT_PASSWORD environment variable or the config file so that it doesn't end
+// up in the shell history.
+type connectionFlags struct {
+	flags      *flag.FlagSet
+	url        *string
+	username   *string
+	caFile     *string
+	insecure   *bool
+	configFile *string
+	profile    *string
+}
+
+func addConnectionFlags(flags *flag.FlagSet) *connectionFlags {
+	profile := os.Getenv("OVIRT_PROFILE")
+	if profile == "" {
+		profile = "default"
+	}
+	return &connectionFlags{
+		flags:      flags,
+		url:        flags.String("url", "", "URL of the oVirt engine API, defaults to OVIRT_URL"),
+		username:   flags.String("username", "", "oVirt username, defaults to OVIRT_USERNAME"),
+		caFile:     flags.String("ca-file", "", "CA certificate file of the engine, defaults to OVIRT_CAFILE"),
+		insecure:   flags.Bool("insecure", false, "disable certificate verification, defaults to OVIRT_INSECURE"),
+		configFile: flags.String("config-file", os.Getenv("OVIRT_CONFIG_FILE"), "INI or YAML file with connection profiles, defaults to OVIRT_CONFIG_FILE"),
+		profile:    flags.String("profile", profile, "profile to read from the config file, defaults to OVIRT_PROFILE or default"),
+	}
+}
+
+// connect connects to the oVirt engine. It must be called after the flags have been parsed. The system certificates
+// are only trusted if neither a CA file nor insecure mode is configured.
+func (c *connectionFlags) connect() (ovirtclient.Client, error) {
+	options := map[string]interface{}{
+		"config_file": *c.configFile,
+		"profile":     *c.profile,
+	}
+	c.flags.Visit(
+		func(f *flag.Flag) {
+			switch f.Name {
+			case "url":
+				options["url"] = *c.url
+			case "username":
+				options["username"] = *c.username
+			case "ca-file":
+				options["tls_ca_files"] = []interface{}{*c.caFile}
+			case "insecure":
+				options["tls_insecure"] = *c.insecure
+			}
+		},
+	)
+	return ovirt.NewClientFromOptions(options, ovirtclientlog.NewGoLogger(log.Default()))
+}
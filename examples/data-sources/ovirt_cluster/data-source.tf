@@ -0,0 +1,7 @@
+data "ovirt_cluster" "default" {
+  name = "Default"
+}
+
+output "cluster_id" {
+  value = data.ovirt_cluster.default.id
+}
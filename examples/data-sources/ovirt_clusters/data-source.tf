@@ -0,0 +1,11 @@
+data "ovirt_datacenter" "default" {
+  name = "Default"
+}
+
+data "ovirt_clusters" "default" {
+  datacenter_id = data.ovirt_datacenter.default.id
+}
+
+output "cluster_ids" {
+  value = data.ovirt_clusters.default.clusters.*.id
+}
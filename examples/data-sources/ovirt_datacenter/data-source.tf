@@ -0,0 +1,7 @@
+data "ovirt_datacenter" "default" {
+  name = "Default"
+}
+
+output "cluster_ids" {
+  value = data.ovirt_datacenter.default.cluster_ids
+}
@@ -0,0 +1,6 @@
+data "ovirt_datacenters" "all" {
+}
+
+output "datacenter_names" {
+  value = data.ovirt_datacenters.all.datacenters.*.name
+}
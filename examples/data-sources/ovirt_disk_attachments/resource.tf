@@ -4,7 +4,7 @@ data "ovirt_blank_template" "blank" {
 resource "ovirt_disk" "test1" {
   storage_domain_id = var.storage_domain_id
   format           = "raw"
-  size             = 1048576
+  size             = "1MiB"
   alias            = "test"
   sparse           = true
 }
@@ -12,7 +12,7 @@ resource "ovirt_disk" "test1" {
 resource "ovirt_disk" "test2" {
   storage_domain_id = var.storage_domain_id
   format           = "raw"
-  size             = 1048576
+  size             = "1MiB"
   alias            = "test"
   sparse           = true
 }
@@ -0,0 +1,6 @@
+data "ovirt_engine_info" "engine" {
+}
+
+output "placement_policy_supported" {
+  value = contains(data.ovirt_engine_info.engine.supported_features, "placement_policy")
+}
@@ -0,0 +1,12 @@
+data "ovirt_hosts" "up" {
+  cluster_id = var.cluster_id
+  status     = "up"
+}
+
+data "ovirt_host" "first" {
+  id = tolist(data.ovirt_hosts.up.hosts)[0].id
+}
+
+output "host_status" {
+  value = data.ovirt_host.first.status
+}
@@ -0,0 +1,8 @@
+data "ovirt_hosts" "up" {
+  cluster_id = var.cluster_id
+  status     = "up"
+}
+
+output "host_ids" {
+  value = data.ovirt_hosts.up.hosts.*.id
+}
@@ -0,0 +1,8 @@
+data "ovirt_networks" "ovirtmgmt" {
+  name          = "ovirtmgmt"
+  fail_on_empty = true
+}
+
+output "network_ids" {
+  value = data.ovirt_networks.ovirtmgmt.networks.*.id
+}
@@ -0,0 +1,7 @@
+data "ovirt_storage_domain" "test" {
+  id = var.storage_domain_id
+}
+
+output "available_bytes" {
+  value = data.ovirt_storage_domain.test.available
+}
@@ -0,0 +1,8 @@
+data "ovirt_storage_domains" "nfs" {
+  storage_type = "nfs"
+  status       = "active"
+}
+
+output "storage_domain_ids" {
+  value = data.ovirt_storage_domains.nfs.storage_domains.*.id
+}
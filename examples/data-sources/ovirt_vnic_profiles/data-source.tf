@@ -0,0 +1,13 @@
+data "ovirt_networks" "ovirtmgmt" {
+  name          = "ovirtmgmt"
+  fail_on_empty = true
+}
+
+data "ovirt_vnic_profiles" "ovirtmgmt" {
+  network_id    = tolist(data.ovirt_networks.ovirtmgmt.networks)[0].id
+  fail_on_empty = true
+}
+
+output "vnic_profile_ids" {
+  value = data.ovirt_vnic_profiles.ovirtmgmt.vnic_profiles.*.id
+}
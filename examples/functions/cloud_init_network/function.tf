@@ -0,0 +1,17 @@
+resource "ovirt_vm" "test" {
+  name        = "web-01"
+  cluster_id  = var.cluster_id
+  template_id = var.template_id
+
+  dynamic "initialization_nic" {
+    for_each = [provider::ovirt::cloud_init_network("eth0", "192.0.2.10/24", "192.0.2.1")]
+    content {
+      name = initialization_nic.value.name
+      ipv4 {
+        address = initialization_nic.value.ipv4[0].address
+        netmask = initialization_nic.value.ipv4[0].netmask
+        gateway = initialization_nic.value.ipv4[0].gateway
+      }
+    }
+  }
+}
@@ -0,0 +1,14 @@
+variable "template" {
+  type        = string
+  description = "Name or ID of the template to use."
+}
+
+data "ovirt_templates" "by_name" {
+  count         = provider::ovirt::is_uuid(var.template) ? 0 : 1
+  name          = var.template
+  fail_on_empty = true
+}
+
+locals {
+  template_id = provider::ovirt::is_uuid(var.template) ? var.template : tolist(data.ovirt_templates.by_name[0].templates)[0].id
+}
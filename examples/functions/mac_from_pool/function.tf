@@ -0,0 +1,8 @@
+resource "ovirt_nic" "test" {
+  count           = 3
+  name            = "eth${count.index}"
+  vm_id           = ovirt_vm.test.id
+  vnic_profile_id = var.vnic_profile_id
+  # 56:6f:00:00:00:00, 56:6f:00:00:00:01 and 56:6f:00:00:00:02
+  mac = provider::ovirt::mac_from_pool("56:6f:00:00:00:00", "56:6f:00:00:ff:ff", count.index)
+}
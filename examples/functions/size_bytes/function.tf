@@ -0,0 +1,4 @@
+output "disk_size" {
+  # 21474836480
+  value = provider::ovirt::size_bytes("20GiB")
+}
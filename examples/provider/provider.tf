@@ -30,4 +30,12 @@ provider "ovirt" {
   extra_headers = {
     "X-Custom-Header" = "Hello world!"
   }
+  # Limit the number of API calls running at the same time across all resources.
+  max_concurrent_requests = 10
+  # Retry API calls failing with transient errors, such as while the engine is restarting.
+  retry {
+    max_attempts          = 10
+    backoff_factor        = 2
+    retryable_error_codes = ["503"]
+  }
 }
@@ -1,2 +1,4 @@
 # Import a disk using the ID from the oVirt Engine.
-terraform import ovirt_disk.test 3b940b57-d3a5-448e-9bb3-0d73b76fbb08
\ No newline at end of file
+terraform import ovirt_disk.test 3b940b57-d3a5-448e-9bb3-0d73b76fbb08
+# Import a disk by its alias.
+terraform import ovirt_disk.test name=web-01-data
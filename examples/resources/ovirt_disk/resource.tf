@@ -1,7 +1,7 @@
 resource "ovirt_disk" "test" {
   storage_domain_id = var.storage_domain_id
   format           = "raw"
-  size             = 1048576
+  size             = "1MiB"
   alias            = "test"
   sparse           = true
 }
\ No newline at end of file
@@ -1,2 +1,4 @@
 # Import a disk attachment using the VM ID and disk attachment ID from the oVirt Engine.
-terraform import ovirt_disk_attachment.test 3b940b57-d3a5-448e-9bb3-0d73b76fbb08/7f7f43a8-7fc9-439e-96a0-2cb1737f9234
\ No newline at end of file
+terraform import ovirt_disk_attachment.test 3b940b57-d3a5-448e-9bb3-0d73b76fbb08/7f7f43a8-7fc9-439e-96a0-2cb1737f9234
+# Import a disk attachment by the name of the VM and the alias of the attached disk.
+terraform import ovirt_disk_attachment.test name=web-01,cluster=prod/name=web-01-data
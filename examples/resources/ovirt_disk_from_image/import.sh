@@ -0,0 +1,3 @@
+# Import a disk using the ID from the oVirt Engine. The image file the disk was uploaded from can be added after a colon
+# so that the imported state matches the configuration.
+terraform import ovirt_disk_from_image.test 3b940b57-d3a5-448e-9bb3-0d73b76fbb08:./testimage/image
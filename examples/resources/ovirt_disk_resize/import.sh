@@ -0,0 +1,2 @@
+# Import the size of a disk using the disk ID from the oVirt Engine.
+terraform import ovirt_disk_resize.test 3b940b57-d3a5-448e-9bb3-0d73b76fbb08
@@ -9,5 +9,5 @@ resource "ovirt_disk_resize" "resized" {
   for_each = {for a in data.ovirt_disk_attachments.templated.attachments: a.id => a.disk_id}
 
   disk_id = "${each.value}"
-  size = 2*1048576
+  size = "2MiB"
 }
\ No newline at end of file
@@ -0,0 +1,4 @@
+# Import a template using the ID from the oVirt Engine.
+terraform import ovirt_template.test 3b940b57-d3a5-448e-9bb3-0d73b76fbb08
+# Import a template by name.
+terraform import ovirt_template.test name=rhel9-base
@@ -1,2 +1,4 @@
 # Import a VM using the ID from the oVirt Engine.
-terraform import ovirt_vm.test 3b940b57-d3a5-448e-9bb3-0d73b76fbb08
\ No newline at end of file
+terraform import ovirt_vm.test 3b940b57-d3a5-448e-9bb3-0d73b76fbb08
+# Import a VM by name. The cluster (name or ID) is optional and narrows down the search if VM names are not unique.
+terraform import ovirt_vm.test name=web-01,cluster=prod
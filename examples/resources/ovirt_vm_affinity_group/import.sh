@@ -0,0 +1,3 @@
+# Import the assignment of a VM to an affinity group using the cluster ID, affinity group ID and VM ID from the oVirt
+# Engine.
+terraform import ovirt_vm_affinity_group.test 3b940b57-d3a5-448e-9bb3-0d73b76fbb08/7f7f43a8-7fc9-439e-96a0-2cb1737f9234/aa8ad0c7-9b9e-4a63-a5ab-3d8f4c8a2e11
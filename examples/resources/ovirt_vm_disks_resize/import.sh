@@ -0,0 +1,2 @@
+# Import the disk sizes of a VM using the VM ID from the oVirt Engine.
+terraform import ovirt_vm_disks_resize.test 3b940b57-d3a5-448e-9bb3-0d73b76fbb08
@@ -0,0 +1,3 @@
+# Import the CPU optimization of a VM using the VM ID from the oVirt Engine. The engine does not report whether the
+# CPU settings of a VM are auto-optimized, so the import only checks that the VM exists.
+terraform import ovirt_vm_optimize_cpu_settings.test 3b940b57-d3a5-448e-9bb3-0d73b76fbb08
@@ -0,0 +1,2 @@
+# Import a VNIC profile using its ID from the oVirt Engine.
+terraform import ovirt_vnic_profile.test 7f7f43a8-7fc9-439e-96a0-2cb1737f9234
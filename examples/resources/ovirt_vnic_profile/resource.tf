@@ -0,0 +1,9 @@
+data "ovirt_networks" "ovirtmgmt" {
+  name          = "ovirtmgmt"
+  fail_on_empty = true
+}
+
+resource "ovirt_vnic_profile" "test" {
+  name       = "test"
+  network_id = tolist(data.ovirt_networks.ovirtmgmt.networks)[0].id
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/ovirt/terraform-provider-ovirt/v2/internal/ovirt"
+)
+
+// exportMockFixtures implements the export-mock-fixtures subcommand, which writes the inventory of a live engine in
+// the format of the mock_fixtures provider option.
+func exportMockFixtures(args []string) error {
+	flags := flag.NewFlagSet("export-mock-fixtures", flag.ExitOnError)
+	connection := addConnectionFlags(flags)
+	output := flags.String("output", "", "file to write the fixtures to, defaults to the standard output")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	client, err := connection.connect()
+	if err != nil {
+		return fmt.Errorf("failed to connect to the oVirt engine (%w)", err)
+	}
+	return writeOutput(*output, func(w io.Writer) error {
+		return ovirt.ExportMockFixtures(client, w)
+	})
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/ovirt/terraform-provider-ovirt/v2/internal/ovirt"
+)
+
+// generateHCL implements the generate-hcl subcommand, which writes resource and import blocks for the inventory of a
+// live engine so that it can be adopted into Terraform.
+func generateHCL(args []string) error {
+	flags := flag.NewFlagSet("generate-hcl", flag.ExitOnError)
+	connection := addConnectionFlags(flags)
+	query := flags.String(
+		"query",
+		"",
+		"only generate the VMs matching the query and the objects they use, for example cluster=prod or name=web-01,cluster=prod",
+	)
+	output := flags.String("output", "", "file to write the configuration to, defaults to the standard output")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	client, err := connection.connect()
+	if err != nil {
+		return fmt.Errorf("failed to connect to the oVirt engine (%w)", err)
+	}
+	return writeOutput(*output, func(w io.Writer) error {
+		return ovirt.GenerateHCL(client, *query, w)
+	})
+}
@@ -0,0 +1,280 @@
+package ovirt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+// retryPolicy describes the provider-wide retry configuration applied to client calls that don't pass their own
+// retry strategies.
+type retryPolicy struct {
+	maxAttempts         uint16
+	backoffFactor       uint8
+	retryableErrorCodes []string
+}
+
+// retryPolicyHandler applies the retry policy to every call that doesn't specify its own retry strategies. Calls
+// that do (for example waiting for a VM status with a user-specified timeout) are left alone.
+func retryPolicyHandler(policy retryPolicy) clientCallHandler {
+	return func(
+		client ovirtclient.Client,
+		_ string,
+		retries []ovirtclient.RetryStrategy,
+		next clientCall,
+	) error {
+		if len(retries) != 0 {
+			return next(retries)
+		}
+		// The strategies that can wait must come first, the client library selects the expired strategy by its
+		// index among the waiting strategies.
+		retries = []ovirtclient.RetryStrategy{
+			ovirtclient.ExponentialBackoff(policy.backoffFactor),
+		}
+		if ctx := client.GetContext(); ctx != nil {
+			retries = append(retries, ovirtclient.ContextStrategy(ctx))
+		} else {
+			retries = append(retries, ovirtclient.Timeout(defaultTimeout))
+		}
+		retries = append(
+			retries,
+			ovirtclient.MaxTries(policy.maxAttempts),
+			ovirtclient.ReconnectStrategy(client),
+			retryableErrorCodes(policy.retryableErrorCodes),
+		)
+		return next(retries)
+	}
+}
+
+// retryableErrorCodes is a retry strategy that retries errors with the specified codes in addition to the ones the
+// client library considers transient. A code may either be a go-ovirt-client error code (e.g. disk_locked) or an
+// HTTP status code returned by the engine (e.g. 503).
+func retryableErrorCodes(codes []string) ovirtclient.RetryStrategy {
+	return &retryableErrorCodesStrategy{
+		codes: codes,
+	}
+}
+
+type retryableErrorCodesStrategy struct {
+	codes []string
+}
+
+func (r *retryableErrorCodesStrategy) Get() ovirtclient.RetryInstance {
+	return &retryableErrorCodesInstance{
+		codes:     r.codes,
+		autoRetry: ovirtclient.AutoRetry().Get(),
+	}
+}
+
+func (r *retryableErrorCodesStrategy) CanClassifyErrors() bool {
+	return true
+}
+
+func (r *retryableErrorCodesStrategy) CanWait() bool {
+	return false
+}
+
+func (r *retryableErrorCodesStrategy) CanTimeout() bool {
+	return false
+}
+
+func (r *retryableErrorCodesStrategy) CanRecover() bool {
+	return false
+}
+
+type retryableErrorCodesInstance struct {
+	codes     []string
+	autoRetry ovirtclient.RetryInstance
+}
+
+func (r *retryableErrorCodesInstance) Continue(err error, action string) error {
+	for _, code := range r.codes {
+		if hasErrorCode(err, code) {
+			return nil
+		}
+	}
+	return r.autoRetry.Continue(err, action)
+}
+
+func (r *retryableErrorCodesInstance) Recover(err error) error {
+	return err
+}
+
+func (r *retryableErrorCodesInstance) Wait(_ error) interface{} {
+	return nil
+}
+
+func (r *retryableErrorCodesInstance) OnWaitExpired(_ error, _ string) error {
+	return nil
+}
+
+// httpStatusCodeMessage matches the HTTP status code in the message of errors built by the oVirt SDK.
+var httpStatusCodeMessage = regexp.MustCompile(`HTTP response code is "(\d+)"`)
+
+// hasErrorCode checks if the error has the specified go-ovirt-client error code, or if the code is numeric, if the
+// error was caused by an engine response with that HTTP status code.
+func hasErrorCode(err error, code string) bool {
+	statusCode, convErr := strconv.Atoi(code)
+	if convErr != nil {
+		// ovirtclient.HasErrorCode panics on errors it cannot identify, so only look at already identified errors.
+		var engineErr ovirtclient.EngineError
+		return errors.As(err, &engineErr) && engineErr.HasCode(ovirtclient.ErrorCode(code))
+	}
+	return httpStatusCode(err) == statusCode
+}
+
+// httpStatusCode returns the HTTP status code of the engine response that caused the error, or 0 if the error was not
+// caused by an engine response.
+func httpStatusCode(err error) int {
+	var authErr *ovirtsdk.AuthError
+	if errors.As(err, &authErr) {
+		return authErr.Code
+	}
+	var notFoundErr *ovirtsdk.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		return notFoundErr.Code
+	}
+	var parseErr *ovirtsdk.ResponseParseError
+	if errors.As(err, &parseErr) {
+		return parseErr.Code
+	}
+	// For all other status codes the SDK returns a plain error, which carries the status code only in its message.
+	for ; err != nil; err = errors.Unwrap(err) {
+		if match := httpStatusCodeMessage.FindStringSubmatch(err.Error()); match != nil {
+			statusCode, _ := strconv.Atoi(match[1])
+			return statusCode
+		}
+	}
+	return 0
+}
+
+// concurrencyLimitHandler allows at most limit client calls to run at the same time across all resources. Calls
+// waiting for a free slot are aborted when their context is canceled. A call only holds its slot while it talks to
+// the engine: the slot is released while the client library waits between attempts, for example during backoff or
+// while polling in WaitFor* methods, and acquired again before the next attempt.
+func concurrencyLimitHandler(limit int) clientCallHandler {
+	semaphore := make(chan struct{}, limit)
+	return func(
+		client ovirtclient.Client,
+		method string,
+		retries []ovirtclient.RetryStrategy,
+		next clientCall,
+	) error {
+		slot := &requestSlot{
+			semaphore: semaphore,
+			ctx:       client.GetContext(),
+			method:    method,
+		}
+		if err := slot.acquire(); err != nil {
+			return err
+		}
+		defer slot.release()
+		return next(slot.wrapRetries(retries))
+	}
+}
+
+// requestSlot is the slot a single client call holds in the concurrency limit. It is only used by the goroutine
+// running the call, so it needs no locking.
+type requestSlot struct {
+	semaphore chan struct{}
+	ctx       context.Context
+	method    string
+	held      bool
+}
+
+func (r *requestSlot) acquire() error {
+	if r.held {
+		return nil
+	}
+	var done <-chan struct{}
+	if r.ctx != nil {
+		done = r.ctx.Done()
+	}
+	select {
+	case r.semaphore <- struct{}{}:
+		r.held = true
+		return nil
+	case <-done:
+		return fmt.Errorf("timeout while waiting for a free request slot to call %s (%w)", r.method, r.ctx.Err())
+	}
+}
+
+func (r *requestSlot) release() {
+	if !r.held {
+		return
+	}
+	<-r.semaphore
+	r.held = false
+}
+
+// wrapRetries wraps the retry strategies of the call so that the slot is released when an attempt fails and
+// acquired again when the wait before the next attempt is over. If the caller didn't pass a waiting strategy, the
+// exponential backoff the client library would add is added here so that the wait can be observed.
+func (r *requestSlot) wrapRetries(retries []ovirtclient.RetryStrategy) []ovirtclient.RetryStrategy {
+	canWait := false
+	for _, retry := range retries {
+		if retry.CanWait() {
+			canWait = true
+		}
+	}
+	if !canWait {
+		// The strategies that can wait must come first, see retryPolicyHandler.
+		retries = append([]ovirtclient.RetryStrategy{ovirtclient.ExponentialBackoff(2)}, retries...)
+	}
+	wrapped := make([]ovirtclient.RetryStrategy, len(retries))
+	for i, retry := range retries {
+		wrapped[i] = &slotReleasingStrategy{
+			RetryStrategy: retry,
+			slot:          r,
+		}
+	}
+	return wrapped
+}
+
+type slotReleasingStrategy struct {
+	ovirtclient.RetryStrategy
+	slot *requestSlot
+}
+
+func (s *slotReleasingStrategy) Get() ovirtclient.RetryInstance {
+	return &slotReleasingInstance{
+		RetryInstance: s.RetryStrategy.Get(),
+		slot:          s.slot,
+		canRecover:    s.RetryStrategy.CanRecover(),
+	}
+}
+
+type slotReleasingInstance struct {
+	ovirtclient.RetryInstance
+	slot       *requestSlot
+	canRecover bool
+}
+
+func (s *slotReleasingInstance) Continue(err error, action string) error {
+	s.slot.release()
+	return s.RetryInstance.Continue(err, action)
+}
+
+func (s *slotReleasingInstance) Recover(err error) error {
+	if !s.canRecover {
+		return s.RetryInstance.Recover(err)
+	}
+	// Recovering, for example by reconnecting, talks to the engine.
+	if slotErr := s.slot.acquire(); slotErr != nil {
+		return slotErr
+	}
+	defer s.slot.release()
+	return s.RetryInstance.Recover(err)
+}
+
+func (s *slotReleasingInstance) OnWaitExpired(err error, action string) error {
+	if err := s.RetryInstance.OnWaitExpired(err, action); err != nil {
+		return err
+	}
+	return s.slot.acquire()
+}
@@ -0,0 +1,200 @@
+package ovirt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	ovirtsdk "github.com/ovirt/go-ovirt"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestProviderRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(newTestLogger(t))
+	name := fmt.Sprintf("%s-%s", t.Name(), p.getTestHelper().GenerateRandomID(5))
+	config := fmt.Sprintf(
+		`
+provider "ovirt" {
+	mock                    = true
+	max_concurrent_requests = 2
+
+	retry {
+		max_attempts          = 3
+		backoff_factor        = 1
+		retryable_error_codes = ["503", "409"]
+	}
+}
+
+resource "ovirt_tag" "foo" {
+	name = "%s"
+}
+`,
+		name,
+	)
+
+	resource.UnitTest(
+		t, resource.TestCase{
+			ProviderFactories: p.getProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestMatchResourceAttr(
+							"ovirt_tag.foo",
+							"name",
+							regexp.MustCompile(name),
+						),
+					),
+				},
+				{
+					Config:  config,
+					Destroy: true,
+				},
+			},
+		},
+	)
+}
+
+func TestRetryableErrorCodes(t *testing.T) {
+	t.Parallel()
+
+	// The errors are built the same way the oVirt SDK builds them from engine responses.
+	unavailable := fmt.Errorf(
+		"failed to list tags (%w)",
+		ovirtsdk.BuildError(&http.Response{StatusCode: 503, Status: "503 Service Unavailable"}, nil),
+	)
+	notFound := fmt.Errorf(
+		"failed to get tag (%w)",
+		ovirtsdk.BuildError(&http.Response{StatusCode: 404, Status: "404 Not Found"}, nil),
+	)
+	internalError := fmt.Errorf(
+		"failed to list tags (%w)",
+		ovirtsdk.BuildError(&http.Response{StatusCode: 500, Status: "500 Internal Server Error"}, nil),
+	)
+	strategy := retryableErrorCodes([]string{"503", "404", string(ovirtclient.EConflict)}).Get()
+
+	if err := strategy.Continue(unavailable, "listing tags"); err != nil {
+		t.Fatalf("HTTP 503 error was not retried (%v)", err)
+	}
+	if err := strategy.Continue(notFound, "getting tag"); err != nil {
+		t.Fatalf("HTTP 404 error was not retried (%v)", err)
+	}
+	if err := strategy.Continue(internalError, "listing tags"); err == nil {
+		t.Fatalf("HTTP 500 error was retried")
+	}
+	if err := strategy.Continue(errors.New("invalid tag name"), "listing tags"); err == nil {
+		t.Fatalf("unidentified error was retried")
+	}
+}
+
+func TestConcurrencyLimitHandler(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(newTestLogger(t))
+	var running, maxRunning int32
+	client := wrapClient(
+		p.getTestHelper().GetClient(),
+		concurrencyLimitHandler(2),
+		func(
+			client ovirtclient.Client,
+			method string,
+			retries []ovirtclient.RetryStrategy,
+			next clientCall,
+		) error {
+			current := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+			for {
+				previous := atomic.LoadInt32(&maxRunning)
+				if current <= previous || atomic.CompareAndSwapInt32(&maxRunning, previous, current) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			return next(retries)
+		},
+	).WithContext(context.Background())
+
+	wg := &sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.ListTags(); err != nil {
+				t.Errorf("failed to list tags (%v)", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if maxRunning != 2 {
+		t.Fatalf("expected at most 2 concurrent calls, got %d", maxRunning)
+	}
+}
+
+func TestConcurrencyLimitHandlerReleasesSlotWhileWaiting(t *testing.T) {
+	t.Parallel()
+
+	handler := concurrencyLimitHandler(1)
+	client := ovirtclient.NewMock()
+	// slotFree checks if another call can get the only slot within a short time.
+	slotFree := func() bool {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		return handler(
+			client.WithContext(ctx), "ListTags", nil, func(_ []ovirtclient.RetryStrategy) error {
+				return nil
+			},
+		) == nil
+	}
+
+	err := handler(
+		client.WithContext(context.Background()),
+		"ListTags",
+		nil,
+		func(retries []ovirtclient.RetryStrategy) error {
+			if slotFree() {
+				return fmt.Errorf("the slot was not held during the attempt")
+			}
+			// Emulate a failed attempt in the retry loop of the client library.
+			instances := make([]ovirtclient.RetryInstance, len(retries))
+			for i, retry := range retries {
+				instances[i] = retry.Get()
+			}
+			attemptErr := errors.New("engine unavailable")
+			for _, instance := range instances {
+				_ = instance.Continue(attemptErr, "listing tags")
+			}
+			if !slotFree() {
+				return fmt.Errorf("the slot was held while waiting for the next attempt")
+			}
+			for i, retry := range retries {
+				if !retry.CanWait() {
+					continue
+				}
+				if err := instances[i].OnWaitExpired(attemptErr, "listing tags"); err != nil {
+					return err
+				}
+				if slotFree() {
+					return fmt.Errorf("the slot was not acquired again for the next attempt")
+				}
+				return nil
+			}
+			return fmt.Errorf("no waiting retry strategy was passed")
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slotFree() {
+		t.Fatalf("the slot was not released after the call")
+	}
+}
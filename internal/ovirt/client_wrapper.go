@@ -0,0 +1,83 @@
+package ovirt
+
+import (
+	"context"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+// clientCall executes a single client method with the passed retry strategies.
+type clientCall func(retries []ovirtclient.RetryStrategy) error
+
+// clientCallHandler intercepts a client call. It receives the underlying client (with the current context applied),
+// the name of the method being called and the retry strategies passed by the caller. It must call next to continue
+// the call chain.
+type clientCallHandler func(
+	client ovirtclient.Client,
+	method string,
+	retries []ovirtclient.RetryStrategy,
+	next clientCall,
+) error
+
+// wrapClient returns a client that passes every API call through the specified handlers before it reaches the
+// underlying client. The first handler is the outermost one. If no handlers are passed the client is returned as is.
+//
+// Only calls made on the client itself pass through the handlers. The objects it returns (VM, Disk, DiskAttachment,
+// etc.) hold the underlying client, so calling their methods (e.g. vm.Start() or disk.WaitForOK()) bypasses the
+// retry policy, the concurrency limit and the injected mock faults. Provider code must use the equivalent client
+// methods instead (e.g. client.StartVM(vm.ID())).
+func wrapClient(client ovirtclient.Client, handlers ...clientCallHandler) ovirtclient.Client {
+	if len(handlers) == 0 {
+		return client
+	}
+	return &wrappedClient{
+		client:   client,
+		handlers: handlers,
+	}
+}
+
+// wrappedClient implements ovirtclient.Client. The methods accepting retry strategies are generated into
+// client_wrapper_generated.go, run go generate after upgrading go-ovirt-client.
+type wrappedClient struct {
+	client   ovirtclient.Client
+	handlers []clientCallHandler
+}
+
+func (w *wrappedClient) call(method string, retries []ovirtclient.RetryStrategy, what clientCall) error {
+	return w.callHandler(0, method, retries, what)
+}
+
+func (w *wrappedClient) callHandler(
+	index int,
+	method string,
+	retries []ovirtclient.RetryStrategy,
+	what clientCall,
+) error {
+	if index == len(w.handlers) {
+		return what(retries)
+	}
+	return w.handlers[index](
+		w.client, method, retries, func(retries []ovirtclient.RetryStrategy) error {
+			return w.callHandler(index+1, method, retries, what)
+		},
+	)
+}
+
+func (w *wrappedClient) GetURL() string {
+	return w.client.GetURL()
+}
+
+func (w *wrappedClient) Reconnect() error {
+	return w.client.Reconnect()
+}
+
+func (w *wrappedClient) WithContext(ctx context.Context) ovirtclient.Client {
+	return &wrappedClient{
+		client:   w.client.WithContext(ctx),
+		handlers: w.handlers,
+	}
+}
+
+func (w *wrappedClient) GetContext() context.Context {
+	return w.client.GetContext()
+}
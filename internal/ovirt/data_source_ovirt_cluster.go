@@ -0,0 +1,215 @@
+package ovirt
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+var clusterDataSourceSchema = map[string]*schema.Schema{
+	"id": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		Description:      "oVirt ID of the cluster. Exactly one of `id` and `name` must be set.",
+		ExactlyOneOf:     []string{"id", "name"},
+		ValidateDiagFunc: validateUUID,
+	},
+	"name": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		Description:      "Name of the cluster. Exactly one of `id` and `name` must be set.",
+		ExactlyOneOf:     []string{"id", "name"},
+		ValidateDiagFunc: validateNonEmpty,
+	},
+	"datacenter_id": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "ID of the data center the cluster belongs to.",
+	},
+}
+
+func (p *provider) clusterDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: p.clusterDataSourceRead,
+		Schema:      clusterDataSourceSchema,
+		Description: `Look up a single oVirt cluster by ID or name.`,
+	}
+}
+
+func (p *provider) clusterDataSourceRead(
+	ctx context.Context,
+	data *schema.ResourceData,
+	_ interface{},
+) diag.Diagnostics {
+	client := p.client.WithContext(ctx)
+	var cluster ovirtclient.Cluster
+	if id, ok := data.GetOk("id"); ok {
+		var err error
+		cluster, err = client.GetCluster(ovirtclient.ClusterID(id.(string)))
+		if err != nil {
+			return errorToDiags(fmt.Sprintf("get cluster %s", id), err)
+		}
+	} else {
+		name := data.Get("name").(string)
+		clusters, err := client.ListClusters()
+		if err != nil {
+			return errorToDiags("list clusters", err)
+		}
+		var found []ovirtclient.Cluster
+		for _, c := range clusters {
+			if c.Name() == name {
+				found = append(found, c)
+			}
+		}
+		if diags := singleResultDiags("cluster", name, len(found)); diags != nil {
+			return diags
+		}
+		cluster = found[0]
+	}
+	clusterDatacenters, err := clusterDatacenterIDs(client)
+	if err != nil {
+		return errorToDiags("list data center clusters", err)
+	}
+
+	diags := diag.Diagnostics{}
+	data.SetId(string(cluster.ID()))
+	diags = setResourceField(data, "name", cluster.Name(), diags)
+	diags = setResourceField(data, "datacenter_id", string(clusterDatacenters[cluster.ID()]), diags)
+	return diags
+}
+
+func (p *provider) clustersDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: p.clustersDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only return clusters with this name.",
+				ValidateDiagFunc: validateNonEmpty,
+			},
+			"datacenter_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only return clusters in this data center.",
+				ValidateDiagFunc: validateUUID,
+			},
+			"clusters": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "oVirt ID of the cluster.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the cluster.",
+						},
+						"datacenter_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the data center the cluster belongs to.",
+						},
+					},
+				},
+			},
+		},
+		Description: `Search oVirt clusters by name and data center.`,
+	}
+}
+
+func (p *provider) clustersDataSourceRead(
+	ctx context.Context,
+	data *schema.ResourceData,
+	_ interface{},
+) diag.Diagnostics {
+	client := p.client.WithContext(ctx)
+	clusters, err := client.ListClusters()
+	if err != nil {
+		return errorToDiags("list clusters", err)
+	}
+	clusterDatacenters, err := clusterDatacenterIDs(client)
+	if err != nil {
+		return errorToDiags("list data center clusters", err)
+	}
+	name := data.Get("name").(string)
+	datacenterID := data.Get("datacenter_id").(string)
+	result := make([]map[string]interface{}, 0)
+	for _, cluster := range clusters {
+		if name != "" && cluster.Name() != name {
+			continue
+		}
+		if datacenterID != "" && string(clusterDatacenters[cluster.ID()]) != datacenterID {
+			continue
+		}
+		result = append(
+			result, map[string]interface{}{
+				"id":            cluster.ID(),
+				"name":          cluster.Name(),
+				"datacenter_id": clusterDatacenters[cluster.ID()],
+			},
+		)
+	}
+	data.SetId(fmt.Sprintf("%s/%s", datacenterID, name))
+	if err := data.Set("clusters", result); err != nil {
+		return errorToDiags("set clusters", err)
+	}
+	return nil
+}
+
+// clusterDatacenterIDs maps each cluster to the data center it belongs to. The cluster object in oVirt does not carry
+// a reference to its data center, so this walks the clusters of each data center instead.
+func clusterDatacenterIDs(client ovirtclient.Client) (map[ovirtclient.ClusterID]ovirtclient.DatacenterID, error) {
+	datacenters, err := client.ListDatacenters()
+	if err != nil {
+		return nil, err
+	}
+	result := map[ovirtclient.ClusterID]ovirtclient.DatacenterID{}
+	for _, datacenter := range datacenters {
+		clusters, err := client.ListDatacenterClusters(datacenter.ID())
+		if err != nil {
+			return nil, err
+		}
+		for _, cluster := range clusters {
+			result[cluster.ID()] = datacenter.ID()
+		}
+	}
+	return result, nil
+}
+
+// singleResultDiags returns an error if a lookup by name did not yield exactly one result.
+func singleResultDiags(objectType string, name string, count int) diag.Diagnostics {
+	switch {
+	case count == 0:
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("No %s found", objectType),
+				Detail:   fmt.Sprintf("No %s with the name %s found.", objectType, name),
+			},
+		}
+	case count > 1:
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Multiple %ss found", objectType),
+				Detail: fmt.Sprintf(
+					"%d objects of type %s with the name %s found, please look it up by ID instead.",
+					count,
+					objectType,
+					name,
+				),
+			},
+		}
+	}
+	return nil
+}
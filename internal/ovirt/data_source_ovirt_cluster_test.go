@@ -0,0 +1,64 @@
+package ovirt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestClusterDataSource(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(newTestLogger(t))
+	clusterID := p.getTestHelper().GetClusterID()
+	cluster, err := p.getTestHelper().GetClient().GetCluster(clusterID)
+	if err != nil {
+		t.Fatalf("failed to fetch test cluster (%v)", err)
+	}
+	config := fmt.Sprintf(
+		`
+provider "ovirt" {
+	mock = true
+}
+
+data "ovirt_cluster" "by_name" {
+	name = "%s"
+}
+
+data "ovirt_cluster" "by_id" {
+	id = "%s"
+}
+
+data "ovirt_clusters" "in_datacenter" {
+	datacenter_id = data.ovirt_cluster.by_id.datacenter_id
+}
+`,
+		cluster.Name(),
+		clusterID,
+	)
+
+	resource.UnitTest(
+		t, resource.TestCase{
+			ProviderFactories: p.getProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.ovirt_cluster.by_name", "id", string(clusterID)),
+						resource.TestCheckResourceAttr("data.ovirt_cluster.by_id", "name", cluster.Name()),
+						resource.TestCheckResourceAttrSet("data.ovirt_cluster.by_id", "datacenter_id"),
+						resource.TestCheckTypeSetElemNestedAttrs(
+							"data.ovirt_clusters.in_datacenter",
+							"clusters.*",
+							map[string]string{
+								"id":   string(clusterID),
+								"name": cluster.Name(),
+							},
+						),
+					),
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,154 @@
+package ovirt
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+var datacenterDataSourceSchema = map[string]*schema.Schema{
+	"id": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		Description:      "oVirt ID of the data center. Exactly one of `id` and `name` must be set.",
+		ExactlyOneOf:     []string{"id", "name"},
+		ValidateDiagFunc: validateUUID,
+	},
+	"name": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		Description:      "Name of the data center. Exactly one of `id` and `name` must be set.",
+		ExactlyOneOf:     []string{"id", "name"},
+		ValidateDiagFunc: validateNonEmpty,
+	},
+	"cluster_ids": {
+		Type:        schema.TypeSet,
+		Computed:    true,
+		Description: "IDs of the clusters in this data center.",
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	},
+}
+
+func (p *provider) datacenterDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: p.datacenterDataSourceRead,
+		Schema:      datacenterDataSourceSchema,
+		Description: `Look up a single oVirt data center by ID or name.`,
+	}
+}
+
+func (p *provider) datacenterDataSourceRead(
+	ctx context.Context,
+	data *schema.ResourceData,
+	_ interface{},
+) diag.Diagnostics {
+	client := p.client.WithContext(ctx)
+	var datacenter ovirtclient.Datacenter
+	if id, ok := data.GetOk("id"); ok {
+		var err error
+		datacenter, err = client.GetDatacenter(ovirtclient.DatacenterID(id.(string)))
+		if err != nil {
+			return errorToDiags(fmt.Sprintf("get data center %s", id), err)
+		}
+	} else {
+		name := data.Get("name").(string)
+		datacenters, err := client.ListDatacenters()
+		if err != nil {
+			return errorToDiags("list data centers", err)
+		}
+		var found []ovirtclient.Datacenter
+		for _, dc := range datacenters {
+			if dc.Name() == name {
+				found = append(found, dc)
+			}
+		}
+		if diags := singleResultDiags("data center", name, len(found)); diags != nil {
+			return diags
+		}
+		datacenter = found[0]
+	}
+	clusters, err := datacenter.Clusters()
+	if err != nil {
+		return errorToDiags("list data center clusters", err)
+	}
+	clusterIDs := make([]string, len(clusters))
+	for i, cluster := range clusters {
+		clusterIDs[i] = string(cluster.ID())
+	}
+
+	diags := diag.Diagnostics{}
+	data.SetId(string(datacenter.ID()))
+	diags = setResourceField(data, "name", datacenter.Name(), diags)
+	diags = setResourceField(data, "cluster_ids", clusterIDs, diags)
+	return diags
+}
+
+func (p *provider) datacentersDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: p.datacentersDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only return data centers with this name.",
+				ValidateDiagFunc: validateNonEmpty,
+			},
+			"datacenters": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "oVirt ID of the data center.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the data center.",
+						},
+					},
+				},
+			},
+		},
+		Description: `Search oVirt data centers by name.`,
+	}
+}
+
+func (p *provider) datacentersDataSourceRead(
+	ctx context.Context,
+	data *schema.ResourceData,
+	_ interface{},
+) diag.Diagnostics {
+	client := p.client.WithContext(ctx)
+	datacenters, err := client.ListDatacenters()
+	if err != nil {
+		return errorToDiags("list data centers", err)
+	}
+	name := data.Get("name").(string)
+	result := make([]map[string]interface{}, 0)
+	for _, datacenter := range datacenters {
+		if name != "" && datacenter.Name() != name {
+			continue
+		}
+		result = append(
+			result, map[string]interface{}{
+				"id":   datacenter.ID(),
+				"name": datacenter.Name(),
+			},
+		)
+	}
+	data.SetId(fmt.Sprintf("datacenters/%s", name))
+	if err := data.Set("datacenters", result); err != nil {
+		return errorToDiags("set data centers", err)
+	}
+	return nil
+}
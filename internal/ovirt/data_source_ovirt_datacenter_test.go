@@ -0,0 +1,54 @@
+package ovirt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDatacenterDataSource(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(newTestLogger(t))
+	config := `
+provider "ovirt" {
+	mock = true
+}
+
+data "ovirt_datacenters" "all" {
+}
+
+data "ovirt_datacenter" "by_name" {
+	name = tolist(data.ovirt_datacenters.all.datacenters)[0].name
+}
+
+data "ovirt_datacenter" "by_id" {
+	id = data.ovirt_datacenter.by_name.id
+}
+`
+
+	resource.UnitTest(
+		t, resource.TestCase{
+			ProviderFactories: p.getProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.ovirt_datacenters.all", "datacenters.#", "1"),
+						resource.TestCheckResourceAttrPair(
+							"data.ovirt_datacenter.by_id",
+							"name",
+							"data.ovirt_datacenter.by_name",
+							"name",
+						),
+						resource.TestCheckTypeSetElemAttr(
+							"data.ovirt_datacenter.by_id",
+							"cluster_ids.*",
+							string(p.getTestHelper().GetClusterID()),
+						),
+					),
+				},
+			},
+		},
+	)
+}
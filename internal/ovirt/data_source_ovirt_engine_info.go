@@ -0,0 +1,43 @@
+package ovirt
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func (p *provider) engineInfoDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: p.engineInfoDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "Always `engine`.",
+				Computed:    true,
+			},
+			"supported_features": {
+				Type:        schema.TypeSet,
+				Description: "Engine features the provider detected as supported, for example `placement_policy`.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		Description: `Returns the features of the connected oVirt Engine the provider detected. The oVirt client library doesn't expose the product version of the engine, so resource attributes that need a newer engine are checked against these features instead of a version.`,
+	}
+}
+
+func (p *provider) engineInfoDataSourceRead(
+	_ context.Context,
+	data *schema.ResourceData,
+	_ interface{},
+) diag.Diagnostics {
+	engine := p.engine
+	if engine == nil {
+		engine = &engineInfo{}
+	}
+	var diags diag.Diagnostics
+	diags = setResourceField(data, "supported_features", engine.features(), diags)
+	data.SetId("engine")
+	return diags
+}
@@ -0,0 +1,40 @@
+package ovirt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestEngineInfoDataSource(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(newTestLogger(t))
+
+	config := `
+provider "ovirt" {
+	mock = true
+}
+
+data "ovirt_engine_info" "engine" {
+}
+`
+
+	resource.UnitTest(
+		t, resource.TestCase{
+			ProviderFactories: p.getProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckTypeSetElemAttr(
+							"data.ovirt_engine_info.engine",
+							"supported_features.*",
+							"placement_policy",
+						),
+					),
+				},
+			},
+		},
+	)
+}
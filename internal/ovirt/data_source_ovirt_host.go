@@ -0,0 +1,138 @@
+package ovirt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+var hostStatusDescription = fmt.Sprintf(
+	"Status of the host. One of: `%s`.",
+	strings.Join(ovirtclient.HostStatusValues().Strings(), "`, `"),
+)
+
+func (p *provider) hostDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: p.hostDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "oVirt ID of the host.",
+				ValidateDiagFunc: validateUUID,
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the cluster the host belongs to.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: hostStatusDescription,
+			},
+		},
+		Description: `Look up a single oVirt host by ID.`,
+	}
+}
+
+func (p *provider) hostDataSourceRead(
+	ctx context.Context,
+	data *schema.ResourceData,
+	_ interface{},
+) diag.Diagnostics {
+	client := p.client.WithContext(ctx)
+	id := data.Get("id").(string)
+	host, err := client.GetHost(ovirtclient.HostID(id))
+	if err != nil {
+		return errorToDiags(fmt.Sprintf("get host %s", id), err)
+	}
+	diags := diag.Diagnostics{}
+	data.SetId(string(host.ID()))
+	diags = setResourceField(data, "cluster_id", string(host.ClusterID()), diags)
+	diags = setResourceField(data, "status", string(host.Status()), diags)
+	return diags
+}
+
+func (p *provider) hostsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: p.hostsDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only return hosts in this cluster.",
+				ValidateDiagFunc: validateUUID,
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only return hosts with this status, for example `up`.",
+				ValidateDiagFunc: validateEnum(ovirtclient.HostStatusValues().Strings()),
+			},
+			"hosts": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "oVirt ID of the host.",
+						},
+						"cluster_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the cluster the host belongs to.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: hostStatusDescription,
+						},
+					},
+				},
+			},
+		},
+		Description: `Search oVirt hosts by cluster and status.`,
+	}
+}
+
+func (p *provider) hostsDataSourceRead(
+	ctx context.Context,
+	data *schema.ResourceData,
+	_ interface{},
+) diag.Diagnostics {
+	client := p.client.WithContext(ctx)
+	hosts, err := client.ListHosts()
+	if err != nil {
+		return errorToDiags("list hosts", err)
+	}
+	clusterID := data.Get("cluster_id").(string)
+	status := data.Get("status").(string)
+	result := make([]map[string]interface{}, 0)
+	for _, host := range hosts {
+		if clusterID != "" && string(host.ClusterID()) != clusterID {
+			continue
+		}
+		if status != "" && string(host.Status()) != status {
+			continue
+		}
+		result = append(
+			result, map[string]interface{}{
+				"id":         host.ID(),
+				"cluster_id": host.ClusterID(),
+				"status":     host.Status(),
+			},
+		)
+	}
+	data.SetId(fmt.Sprintf("%s/%s", clusterID, status))
+	if err := data.Set("hosts", result); err != nil {
+		return errorToDiags("set hosts", err)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package ovirt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestHostDataSource(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(newTestLogger(t))
+	clusterID := p.getTestHelper().GetClusterID()
+	config := fmt.Sprintf(
+		`
+provider "ovirt" {
+	mock = true
+}
+
+data "ovirt_hosts" "up" {
+	cluster_id = "%s"
+	status     = "up"
+}
+
+data "ovirt_host" "test" {
+	id = tolist(data.ovirt_hosts.up.hosts)[0].id
+}
+`,
+		clusterID,
+	)
+
+	resource.UnitTest(
+		t, resource.TestCase{
+			ProviderFactories: p.getProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.ovirt_host.test", "cluster_id", string(clusterID)),
+						resource.TestCheckResourceAttr("data.ovirt_host.test", "status", "up"),
+					),
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,103 @@
+package ovirt
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func (p *provider) networksDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: p.networksDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only return networks with this name.",
+				ValidateDiagFunc: validateNonEmpty,
+			},
+			"datacenter_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only return networks in this data center.",
+				ValidateDiagFunc: validateUUID,
+			},
+			"fail_on_empty": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Fail if no networks matching the criteria were found.",
+			},
+			"networks": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "oVirt identifier for the network.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the network.",
+						},
+						"datacenter_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the data center the network belongs to.",
+						},
+					},
+				},
+			},
+		},
+		Description: `Search oVirt logical networks by name and data center.`,
+	}
+}
+
+func (p *provider) networksDataSourceRead(
+	ctx context.Context,
+	data *schema.ResourceData,
+	_ interface{},
+) diag.Diagnostics {
+	client := p.client.WithContext(ctx)
+	networks, err := client.ListNetworks()
+	if err != nil {
+		return errorToDiags("list networks", err)
+	}
+	name := data.Get("name").(string)
+	datacenterID := data.Get("datacenter_id").(string)
+	result := make([]map[string]interface{}, 0)
+	for _, network := range networks {
+		if name != "" && network.Name() != name {
+			continue
+		}
+		if datacenterID != "" && string(network.DatacenterID()) != datacenterID {
+			continue
+		}
+		result = append(
+			result, map[string]interface{}{
+				"id":            network.ID(),
+				"name":          network.Name(),
+				"datacenter_id": network.DatacenterID(),
+			},
+		)
+	}
+	data.SetId(fmt.Sprintf("%s/%s", datacenterID, name))
+	if err := data.Set("networks", result); err != nil {
+		return errorToDiags("set networks", err)
+	}
+	if data.Get("fail_on_empty").(bool) && len(result) == 0 {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "No network found",
+				Detail:   fmt.Sprintf("No network matching name %q in data center %q found.", name, datacenterID),
+			},
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package ovirt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestNetworksDataSource(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(newTestLogger(t))
+	networkID := getTestNetworkID(t, p)
+	network, err := p.getTestHelper().GetClient().GetNetwork(networkID)
+	if err != nil {
+		t.Fatalf("failed to fetch test network (%v)", err)
+	}
+	config := fmt.Sprintf(
+		`
+provider "ovirt" {
+	mock = true
+}
+
+data "ovirt_networks" "test" {
+	name          = "%s"
+	datacenter_id = "%s"
+	fail_on_empty = true
+}
+`,
+		network.Name(),
+		network.DatacenterID(),
+	)
+
+	resource.UnitTest(
+		t, resource.TestCase{
+			ProviderFactories: p.getProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.ovirt_networks.test", "networks.#", "1"),
+						resource.TestCheckTypeSetElemNestedAttrs(
+							"data.ovirt_networks.test",
+							"networks.*",
+							map[string]string{
+								"id":            string(networkID),
+								"datacenter_id": string(network.DatacenterID()),
+							},
+						),
+					),
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,212 @@
+package ovirt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+var storageDomainAttributesSchema = map[string]*schema.Schema{
+	"available": {
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "Free space on the storage domain in bytes.",
+	},
+	"storage_type": {
+		Type:     schema.TypeString,
+		Computed: true,
+		Description: fmt.Sprintf(
+			"Type of the storage domain. One of: `%s`.",
+			strings.Join(storageDomainTypeValues(), "`, `"),
+		),
+	},
+	"status": {
+		Type:     schema.TypeString,
+		Computed: true,
+		Description: fmt.Sprintf(
+			"Status of the storage domain. One of: `%s`. May be empty for external storage domains.",
+			strings.Join(ovirtclient.StorageDomainStatusValues().Strings(), "`, `"),
+		),
+	},
+	"external_status": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "External status of the storage domain.",
+	},
+}
+
+var storageDomainDataSourceSchema = schemaMerge(
+	map[string]*schema.Schema{
+		"id": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			Description:      "oVirt ID of the storage domain. Exactly one of `id` and `name` must be set.",
+			ExactlyOneOf:     []string{"id", "name"},
+			ValidateDiagFunc: validateUUID,
+		},
+		"name": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			Description:      "Name of the storage domain. Exactly one of `id` and `name` must be set.",
+			ExactlyOneOf:     []string{"id", "name"},
+			ValidateDiagFunc: validateNonEmpty,
+		},
+	},
+	storageDomainAttributesSchema,
+)
+
+func (p *provider) storageDomainDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: p.storageDomainDataSourceRead,
+		Schema:      storageDomainDataSourceSchema,
+		Description: `Look up a single oVirt storage domain by ID or name.`,
+	}
+}
+
+func (p *provider) storageDomainDataSourceRead(
+	ctx context.Context,
+	data *schema.ResourceData,
+	_ interface{},
+) diag.Diagnostics {
+	client := p.client.WithContext(ctx)
+	var storageDomain ovirtclient.StorageDomain
+	if id, ok := data.GetOk("id"); ok {
+		var err error
+		storageDomain, err = client.GetStorageDomain(ovirtclient.StorageDomainID(id.(string)))
+		if err != nil {
+			return errorToDiags(fmt.Sprintf("get storage domain %s", id), err)
+		}
+	} else {
+		name := data.Get("name").(string)
+		storageDomains, err := client.ListStorageDomains()
+		if err != nil {
+			return errorToDiags("list storage domains", err)
+		}
+		found := storageDomains.Filter(
+			func(sd ovirtclient.StorageDomain) bool {
+				return sd.Name() == name
+			},
+		)
+		if diags := singleResultDiags("storage domain", name, len(found)); diags != nil {
+			return diags
+		}
+		storageDomain = found[0]
+	}
+
+	diags := diag.Diagnostics{}
+	data.SetId(string(storageDomain.ID()))
+	for k, v := range flattenStorageDomain(storageDomain) {
+		if k == "id" {
+			continue
+		}
+		diags = setResourceField(data, k, v, diags)
+	}
+	return diags
+}
+
+func (p *provider) storageDomainsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: p.storageDomainsDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only return storage domains with this name.",
+				ValidateDiagFunc: validateNonEmpty,
+			},
+			"storage_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only return storage domains of this type, for example `nfs`.",
+				ValidateDiagFunc: validateEnum(storageDomainTypeValues()),
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only return storage domains with this status, for example `active`.",
+				ValidateDiagFunc: validateEnum(ovirtclient.StorageDomainStatusValues().Strings()),
+			},
+			"storage_domains": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: schemaMerge(
+						map[string]*schema.Schema{
+							"id": {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "oVirt ID of the storage domain.",
+							},
+							"name": {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "Name of the storage domain.",
+							},
+						},
+						storageDomainAttributesSchema,
+					),
+				},
+			},
+		},
+		Description: `Search oVirt storage domains by name, type and status.`,
+	}
+}
+
+func (p *provider) storageDomainsDataSourceRead(
+	ctx context.Context,
+	data *schema.ResourceData,
+	_ interface{},
+) diag.Diagnostics {
+	client := p.client.WithContext(ctx)
+	storageDomains, err := client.ListStorageDomains()
+	if err != nil {
+		return errorToDiags("list storage domains", err)
+	}
+	name := data.Get("name").(string)
+	storageType := data.Get("storage_type").(string)
+	status := data.Get("status").(string)
+	result := make([]map[string]interface{}, 0)
+	for _, storageDomain := range storageDomains {
+		if name != "" && storageDomain.Name() != name {
+			continue
+		}
+		if storageType != "" && string(storageDomain.StorageType()) != storageType {
+			continue
+		}
+		if status != "" && string(storageDomain.Status()) != status {
+			continue
+		}
+		result = append(result, flattenStorageDomain(storageDomain))
+	}
+	data.SetId(fmt.Sprintf("%s/%s/%s", name, storageType, status))
+	if err := data.Set("storage_domains", result); err != nil {
+		return errorToDiags("set storage domains", err)
+	}
+	return nil
+}
+
+func storageDomainTypeValues() []string {
+	values := ovirtclient.StorageDomainTypeValues()
+	result := make([]string, len(values))
+	for i, value := range values {
+		result[i] = string(value)
+	}
+	return result
+}
+
+func flattenStorageDomain(storageDomain ovirtclient.StorageDomainData) map[string]interface{} {
+	return map[string]interface{}{
+		"id":              string(storageDomain.ID()),
+		"name":            storageDomain.Name(),
+		"available":       int(storageDomain.Available()),
+		"storage_type":    string(storageDomain.StorageType()),
+		"status":          string(storageDomain.Status()),
+		"external_status": string(storageDomain.ExternalStatus()),
+	}
+}
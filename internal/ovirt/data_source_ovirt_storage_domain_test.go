@@ -0,0 +1,104 @@
+package ovirt
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestStorageDomainDataSource(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(newTestLogger(t))
+	storageDomainID := p.getTestHelper().GetStorageDomainID()
+	config := fmt.Sprintf(
+		`
+provider "ovirt" {
+	mock = true
+}
+
+data "ovirt_storage_domain" "test" {
+	id = "%s"
+}
+
+data "ovirt_storage_domains" "active" {
+	status = "active"
+}
+`,
+		storageDomainID,
+	)
+
+	resource.UnitTest(
+		t, resource.TestCase{
+			ProviderFactories: p.getProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrSet("data.ovirt_storage_domain.test", "name"),
+						resource.TestCheckResourceAttrSet("data.ovirt_storage_domain.test", "available"),
+						resource.TestCheckResourceAttrSet("data.ovirt_storage_domain.test", "storage_type"),
+						resource.TestCheckResourceAttr("data.ovirt_storage_domain.test", "status", "active"),
+						resource.TestCheckTypeSetElemNestedAttrs(
+							"data.ovirt_storage_domains.active",
+							"storage_domains.*",
+							map[string]string{
+								"id": string(storageDomainID),
+							},
+						),
+					),
+				},
+			},
+		},
+	)
+}
+
+func TestStorageDomainDataSourceAmbiguousName(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(newTestLogger(t))
+	client := p.getTestHelper().GetClient()
+	storageDomain, err := client.GetStorageDomain(p.getTestHelper().GetStorageDomainID())
+	if err != nil {
+		t.Fatalf("failed to fetch test storage domain (%v)", err)
+	}
+	storageDomains, err := client.ListStorageDomains()
+	if err != nil {
+		t.Fatalf("failed to list storage domains (%v)", err)
+	}
+	sameName := 0
+	for _, sd := range storageDomains {
+		if sd.Name() == storageDomain.Name() {
+			sameName++
+		}
+	}
+	if sameName < 2 {
+		t.Skipf("the test backend has only one storage domain named %s", storageDomain.Name())
+	}
+	config := fmt.Sprintf(
+		`
+provider "ovirt" {
+	mock = true
+}
+
+data "ovirt_storage_domain" "test" {
+	name = "%s"
+}
+`,
+		storageDomain.Name(),
+	)
+
+	resource.UnitTest(
+		t, resource.TestCase{
+			ProviderFactories: p.getProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      config,
+					ExpectError: regexp.MustCompile("Multiple storage domains found"),
+				},
+			},
+		},
+	)
+}
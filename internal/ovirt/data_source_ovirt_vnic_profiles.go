@@ -0,0 +1,134 @@
+package ovirt
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func (p *provider) vnicProfilesDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: p.vnicProfilesDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only return VNIC profiles with this name.",
+				ValidateDiagFunc: validateNonEmpty,
+			},
+			"network_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only return VNIC profiles on this network.",
+				ValidateDiagFunc: validateUUID,
+			},
+			"datacenter_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only return VNIC profiles on networks in this data center.",
+				ValidateDiagFunc: validateUUID,
+			},
+			"fail_on_empty": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Fail if no VNIC profiles matching the criteria were found.",
+			},
+			"vnic_profiles": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "oVirt identifier for the VNIC profile.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the VNIC profile.",
+						},
+						"network_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the network the VNIC profile belongs to.",
+						},
+					},
+				},
+			},
+		},
+		Description: `Search oVirt VNIC profiles by name, network and data center.`,
+	}
+}
+
+func (p *provider) vnicProfilesDataSourceRead(
+	ctx context.Context,
+	data *schema.ResourceData,
+	_ interface{},
+) diag.Diagnostics {
+	client := p.client.WithContext(ctx)
+	vnicProfiles, err := client.ListVNICProfiles()
+	if err != nil {
+		return errorToDiags("list VNIC profiles", err)
+	}
+	name := data.Get("name").(string)
+	networkID := data.Get("network_id").(string)
+	datacenterID := data.Get("datacenter_id").(string)
+
+	var datacenterNetworks map[ovirtclient.NetworkID]bool
+	if datacenterID != "" {
+		networks, err := client.ListNetworks()
+		if err != nil {
+			return errorToDiags("list networks", err)
+		}
+		datacenterNetworks = make(map[ovirtclient.NetworkID]bool, len(networks))
+		for _, network := range networks {
+			if string(network.DatacenterID()) == datacenterID {
+				datacenterNetworks[network.ID()] = true
+			}
+		}
+	}
+
+	result := make([]map[string]interface{}, 0)
+	for _, vnicProfile := range vnicProfiles {
+		if name != "" && vnicProfile.Name() != name {
+			continue
+		}
+		if networkID != "" && string(vnicProfile.NetworkID()) != networkID {
+			continue
+		}
+		if datacenterNetworks != nil && !datacenterNetworks[vnicProfile.NetworkID()] {
+			continue
+		}
+		result = append(
+			result, map[string]interface{}{
+				"id":         vnicProfile.ID(),
+				"name":       vnicProfile.Name(),
+				"network_id": vnicProfile.NetworkID(),
+			},
+		)
+	}
+	data.SetId(fmt.Sprintf("%s/%s/%s", datacenterID, networkID, name))
+	if err := data.Set("vnic_profiles", result); err != nil {
+		return errorToDiags("set VNIC profiles", err)
+	}
+	if data.Get("fail_on_empty").(bool) && len(result) == 0 {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "No VNIC profile found",
+				Detail: fmt.Sprintf(
+					"No VNIC profile matching name %q on network %q in data center %q found.",
+					name,
+					networkID,
+					datacenterID,
+				),
+			},
+		}
+	}
+	return nil
+}
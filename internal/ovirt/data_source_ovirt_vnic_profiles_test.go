@@ -0,0 +1,85 @@
+package ovirt
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestVNICProfilesDataSource(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(newTestLogger(t))
+	networkID := getTestNetworkID(t, p)
+	name := fmt.Sprintf("%s-%s", t.Name(), p.getTestHelper().GenerateRandomID(5))
+	config := fmt.Sprintf(
+		`
+provider "ovirt" {
+	mock = true
+}
+
+resource "ovirt_vnic_profile" "test" {
+	name       = "%s"
+	network_id = "%s"
+}
+
+data "ovirt_vnic_profiles" "test" {
+	name          = ovirt_vnic_profile.test.name
+	network_id    = ovirt_vnic_profile.test.network_id
+	fail_on_empty = true
+}
+`,
+		name,
+		networkID,
+	)
+
+	resource.UnitTest(
+		t, resource.TestCase{
+			ProviderFactories: p.getProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.ovirt_vnic_profiles.test", "vnic_profiles.#", "1"),
+						resource.TestCheckTypeSetElemAttrPair(
+							"data.ovirt_vnic_profiles.test",
+							"vnic_profiles.*.id",
+							"ovirt_vnic_profile.test",
+							"id",
+						),
+					),
+				},
+			},
+		},
+	)
+}
+
+func TestVNICProfilesDataSourceFailOnEmpty(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(newTestLogger(t))
+	config := `
+provider "ovirt" {
+	mock = true
+}
+
+data "ovirt_vnic_profiles" "test" {
+	name          = "does-not-exist"
+	fail_on_empty = true
+}
+`
+
+	resource.UnitTest(
+		t, resource.TestCase{
+			ProviderFactories: p.getProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      config,
+					ExpectError: regexp.MustCompile("No VNIC profile found"),
+				},
+			},
+		},
+	)
+}
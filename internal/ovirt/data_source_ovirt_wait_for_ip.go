@@ -11,6 +11,9 @@ import (
 func (p *provider) waitForIPDataSource() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: p.waitForIPDataSourceRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultTimeout),
+		},
 		Schema: map[string]*schema.Schema{
 			"vm_id": {
 				Type:        schema.TypeString,
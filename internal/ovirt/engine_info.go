@@ -0,0 +1,100 @@
+package ovirt
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+// engineFeature is an oVirt Engine feature some resource attributes depend on, together with the engine version that
+// introduced it.
+type engineFeature struct {
+	feature        ovirtclient.Feature
+	minimumVersion *version.Version
+}
+
+// engineFeatures lists the features the provider detects when it is configured. go-ovirt-client doesn't expose the
+// product version of the engine, only whether it supports a feature, so attributes are gated on features.
+var engineFeatures = []engineFeature{
+	{ovirtclient.FeatureAutoPinning, version.Must(version.NewVersion("4.4.5"))},
+	{ovirtclient.FeaturePlacementPolicy, version.Must(version.NewVersion("4.4.5"))},
+}
+
+// engineInfo describes the capabilities of the connected engine.
+type engineInfo struct {
+	// supportedFeatures contains the detected features. Features that couldn't be detected are missing.
+	supportedFeatures map[ovirtclient.Feature]bool
+}
+
+// detectEngineInfo queries the engine for the features in engineFeatures. Failures are reported as warnings because
+// they shouldn't prevent using the provider; features that couldn't be detected are not gated.
+func detectEngineInfo(client ovirtclient.Client) (*engineInfo, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	info := &engineInfo{
+		supportedFeatures: map[ovirtclient.Feature]bool{},
+	}
+	// Each feature is probed on its own. The minimum versions are only used in error messages, the client decides
+	// how a feature is detected.
+	for _, f := range engineFeatures {
+		supported, err := client.SupportsFeature(f.feature)
+		if err != nil {
+			diags = append(
+				diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("Failed to detect support for the %s engine feature", f.feature),
+					Detail: fmt.Sprintf(
+						"Attributes that require the %s feature will not be checked at plan time. (%v)",
+						f.feature,
+						err,
+					),
+				},
+			)
+			continue
+		}
+		info.supportedFeatures[f.feature] = supported
+	}
+	return info, diags
+}
+
+// features returns the names of the supported features in alphabetical order.
+func (e *engineInfo) features() []string {
+	var result []string
+	for feature, supported := range e.supportedFeatures {
+		if supported {
+			result = append(result, string(feature))
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// requireFeature returns an error explaining that the attribute needs a newer engine if the engine is known not to
+// support the feature. It is meant to be called from CustomizeDiff so the error surfaces at plan time.
+func (e *engineInfo) requireFeature(feature ovirtclient.Feature, resourceType string, attribute string) error {
+	if e == nil {
+		return nil
+	}
+	if supported, ok := e.supportedFeatures[feature]; !ok || supported {
+		return nil
+	}
+	for _, f := range engineFeatures {
+		if f.feature == feature {
+			return fmt.Errorf(
+				"%s.%s requires oVirt Engine %s or newer, but the connected engine does not support the %s feature",
+				resourceType,
+				attribute,
+				f.minimumVersion,
+				feature,
+			)
+		}
+	}
+	return fmt.Errorf(
+		"%s.%s requires the %s feature, which the connected engine does not support",
+		resourceType,
+		attribute,
+		feature,
+	)
+}
@@ -0,0 +1,62 @@
+package ovirt
+
+import (
+	"strings"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestEngineInfo(t *testing.T) {
+	t.Parallel()
+
+	unknown := &engineInfo{supportedFeatures: map[ovirtclient.Feature]bool{}}
+	if features := unknown.features(); len(features) != 0 {
+		t.Fatalf("unexpected features for an unknown engine: %v", features)
+	}
+	if err := unknown.requireFeature(ovirtclient.FeaturePlacementPolicy, "ovirt_vm", "placement_policy_affinity"); err != nil {
+		t.Fatalf("undetected feature was rejected (%v)", err)
+	}
+
+	old := &engineInfo{
+		supportedFeatures: map[ovirtclient.Feature]bool{
+			ovirtclient.FeatureAutoPinning:     false,
+			ovirtclient.FeaturePlacementPolicy: false,
+		},
+	}
+	if features := old.features(); len(features) != 0 {
+		t.Fatalf("unexpected features for an old engine: %v", features)
+	}
+	err := old.requireFeature(ovirtclient.FeaturePlacementPolicy, "ovirt_vm", "placement_policy_affinity")
+	if err == nil {
+		t.Fatalf("unsupported feature was not rejected")
+	}
+	if !strings.Contains(err.Error(), "ovirt_vm.placement_policy_affinity requires oVirt Engine 4.4.5 or newer") {
+		t.Fatalf("unexpected error message: %v", err)
+	}
+
+	calls := 0
+	client := wrapClient(
+		newProvider(newTestLogger(t)).getTestHelper().GetClient(),
+		func(_ ovirtclient.Client, method string, retries []ovirtclient.RetryStrategy, next clientCall) error {
+			if method == "SupportsFeature" {
+				calls++
+			}
+			return next(retries)
+		},
+	)
+	current, diags := detectEngineInfo(client)
+	if diags.HasError() {
+		t.Fatalf("failed to detect engine info (%v)", diags)
+	}
+	// Every feature is probed, even if it was introduced in the same engine version as another one.
+	if calls != len(engineFeatures) {
+		t.Fatalf("the engine was queried %d times instead of %d", calls, len(engineFeatures))
+	}
+	if features := strings.Join(current.features(), ","); features != "autopinning,placement_policy" {
+		t.Fatalf("unexpected features for the mock engine: %s", features)
+	}
+	if err := current.requireFeature(ovirtclient.FeatureAutoPinning, "ovirt_vm_optimize_cpu_settings", "vm_id"); err != nil {
+		t.Fatalf("supported feature was rejected (%v)", err)
+	}
+}
@@ -0,0 +1,332 @@
+package ovirt
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"net"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// providerFunction is a provider-defined function. The terraform-plugin-sdk doesn't support provider functions, so
+// they are served by functionServer next to the resources and data sources of the SDK provider.
+type providerFunction struct {
+	definition *tfprotov5.Function
+	// call is invoked with the arguments decoded according to the parameter types of the definition.
+	call func(args []tftypes.Value) (tftypes.Value, *tfprotov5.FunctionError)
+}
+
+var nicIPType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"address": tftypes.String,
+		"netmask": tftypes.String,
+		"gateway": tftypes.String,
+	},
+}
+
+// nicConfigurationType is the type of an element of the initialization_nic attribute of ovirt_vm.
+var nicConfigurationType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"name": tftypes.String,
+		"ipv4": tftypes.List{ElementType: nicIPType},
+		"ipv6": tftypes.List{ElementType: nicIPType},
+	},
+}
+
+var providerFunctions = map[string]providerFunction{
+	"size_bytes": {
+		definition: &tfprotov5.Function{
+			Summary:         "Convert a size to bytes",
+			Description:     "Converts a number of bytes or a size with a unit suffix, such as `20GiB`, `512MiB` or `1TB`, to a number of bytes.",
+			DescriptionKind: tfprotov5.StringKindMarkdown,
+			Parameters: []*tfprotov5.FunctionParameter{
+				{
+					Name:        "size",
+					Type:        tftypes.String,
+					Description: "Size with an optional unit suffix.",
+				},
+			},
+			Return: &tfprotov5.FunctionReturn{Type: tftypes.Number},
+		},
+		call: func(args []tftypes.Value) (tftypes.Value, *tfprotov5.FunctionError) {
+			var size string
+			if err := args[0].As(&size); err != nil {
+				return tftypes.Value{}, functionArgumentError(0, err)
+			}
+			bytes, err := parseSize(size)
+			if err != nil {
+				return tftypes.Value{}, functionArgumentError(0, err)
+			}
+			return tftypes.NewValue(tftypes.Number, new(big.Float).SetUint64(bytes)), nil
+		},
+	},
+	"is_uuid": {
+		definition: &tfprotov5.Function{
+			Summary:         "Check if a string is an oVirt ID",
+			Description:     "Returns true if the value is a lowercase UUID as used for the IDs of oVirt objects.",
+			DescriptionKind: tfprotov5.StringKindMarkdown,
+			Parameters: []*tfprotov5.FunctionParameter{
+				{
+					Name:        "value",
+					Type:        tftypes.String,
+					Description: "Value to check.",
+				},
+			},
+			Return: &tfprotov5.FunctionReturn{Type: tftypes.Bool},
+		},
+		call: func(args []tftypes.Value) (tftypes.Value, *tfprotov5.FunctionError) {
+			var value string
+			if err := args[0].As(&value); err != nil {
+				return tftypes.Value{}, functionArgumentError(0, err)
+			}
+			return tftypes.NewValue(tftypes.Bool, uuidRegexp.MatchString(value)), nil
+		},
+	},
+	"mac_from_pool": {
+		definition: &tfprotov5.Function{
+			Summary: "Pick a MAC address from a MAC address range",
+			Description: "Returns the MAC address at the specified zero-based index of a MAC address range, such as the " +
+				"range of an oVirt MAC address pool. Provider functions can't query the engine, so the range has to be " +
+				"passed explicitly and the function can't tell whether the address is already in use.",
+			DescriptionKind: tfprotov5.StringKindMarkdown,
+			Parameters: []*tfprotov5.FunctionParameter{
+				{
+					Name:        "from",
+					Type:        tftypes.String,
+					Description: "First MAC address of the range.",
+				},
+				{
+					Name:        "to",
+					Type:        tftypes.String,
+					Description: "Last MAC address of the range.",
+				},
+				{
+					Name:        "index",
+					Type:        tftypes.Number,
+					Description: "Zero-based index of the address in the range.",
+				},
+			},
+			Return: &tfprotov5.FunctionReturn{Type: tftypes.String},
+		},
+		call: func(args []tftypes.Value) (tftypes.Value, *tfprotov5.FunctionError) {
+			from, funcErr := macArgument(args, 0)
+			if funcErr != nil {
+				return tftypes.Value{}, funcErr
+			}
+			to, funcErr := macArgument(args, 1)
+			if funcErr != nil {
+				return tftypes.Value{}, funcErr
+			}
+			if to < from {
+				return tftypes.Value{}, functionArgumentError(1, fmt.Errorf("the end of the MAC address range is before its start"))
+			}
+			var index big.Float
+			if err := args[2].As(&index); err != nil {
+				return tftypes.Value{}, functionArgumentError(2, err)
+			}
+			i, accuracy := index.Uint64()
+			if accuracy != big.Exact || index.Sign() < 0 || i > to-from {
+				return tftypes.Value{}, functionArgumentError(
+					2,
+					fmt.Errorf("index %s is not a whole number between 0 and %d", index.String(), to-from),
+				)
+			}
+			return tftypes.NewValue(tftypes.String, formatMAC(from+i)), nil
+		},
+	},
+	"cloud_init_network": {
+		definition: &tfprotov5.Function{
+			Summary: "Render an initialization_nic configuration",
+			Description: "Returns the configuration of a static IPv4 address in the structure of the `initialization_nic` " +
+				"block of `ovirt_vm`, for use in a `dynamic \"initialization_nic\"` block.",
+			DescriptionKind: tfprotov5.StringKindMarkdown,
+			Parameters: []*tfprotov5.FunctionParameter{
+				{
+					Name:        "name",
+					Type:        tftypes.String,
+					Description: "Name of the network interface in the guest, for example `eth0`.",
+				},
+				{
+					Name:        "address",
+					Type:        tftypes.String,
+					Description: "IPv4 address with prefix length, for example `192.0.2.10/24`.",
+				},
+				{
+					Name:        "gateway",
+					Type:        tftypes.String,
+					Description: "IPv4 address of the default gateway.",
+				},
+			},
+			Return: &tfprotov5.FunctionReturn{Type: nicConfigurationType},
+		},
+		call: func(args []tftypes.Value) (tftypes.Value, *tfprotov5.FunctionError) {
+			values := make([]string, len(args))
+			for i, arg := range args {
+				if err := arg.As(&values[i]); err != nil {
+					return tftypes.Value{}, functionArgumentError(i, err)
+				}
+			}
+			ip, network, err := net.ParseCIDR(values[1])
+			if err != nil || ip.To4() == nil {
+				return tftypes.Value{}, functionArgumentError(
+					1,
+					fmt.Errorf("%q is not an IPv4 address with prefix length, such as 192.0.2.10/24", values[1]),
+				)
+			}
+			if gateway := net.ParseIP(values[2]); gateway == nil || gateway.To4() == nil {
+				return tftypes.Value{}, functionArgumentError(2, fmt.Errorf("%q is not an IPv4 address", values[2]))
+			}
+			ipv4 := tftypes.NewValue(
+				nicIPType, map[string]tftypes.Value{
+					"address": tftypes.NewValue(tftypes.String, ip.String()),
+					"netmask": tftypes.NewValue(tftypes.String, net.IP(network.Mask).String()),
+					"gateway": tftypes.NewValue(tftypes.String, values[2]),
+				},
+			)
+			return tftypes.NewValue(
+				nicConfigurationType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, values[0]),
+					"ipv4": tftypes.NewValue(tftypes.List{ElementType: nicIPType}, []tftypes.Value{ipv4}),
+					"ipv6": tftypes.NewValue(tftypes.List{ElementType: nicIPType}, []tftypes.Value{}),
+				},
+			), nil
+		},
+	},
+}
+
+func functionArgumentError(argument int, err error) *tfprotov5.FunctionError {
+	position := int64(argument)
+	return &tfprotov5.FunctionError{
+		Text:             err.Error(),
+		FunctionArgument: &position,
+	}
+}
+
+// macArgument decodes a MAC address argument into an integer so that ranges can be computed.
+func macArgument(args []tftypes.Value, argument int) (uint64, *tfprotov5.FunctionError) {
+	var value string
+	if err := args[argument].As(&value); err != nil {
+		return 0, functionArgumentError(argument, err)
+	}
+	mac, err := net.ParseMAC(value)
+	if err != nil || len(mac) != 6 {
+		return 0, functionArgumentError(argument, fmt.Errorf("%q is not a MAC address", value))
+	}
+	return binary.BigEndian.Uint64(append([]byte{0, 0}, mac...)), nil
+}
+
+func formatMAC(mac uint64) string {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, mac)
+	return net.HardwareAddr(b[2:]).String()
+}
+
+// WithFunctions adds the provider-defined functions to the protocol server of the SDK provider.
+func WithFunctions(server tfprotov5.ProviderServer) tfprotov5.ProviderServer {
+	return &functionServer{ProviderServer: server}
+}
+
+// functionServer serves the provider-defined functions and passes all other calls to the SDK provider server.
+type functionServer struct {
+	tfprotov5.ProviderServer
+}
+
+func (f *functionServer) GetMetadata(
+	ctx context.Context,
+	req *tfprotov5.GetMetadataRequest,
+) (*tfprotov5.GetMetadataResponse, error) {
+	resp, err := f.ProviderServer.GetMetadata(ctx, req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+	names := make([]string, 0, len(providerFunctions))
+	for name := range providerFunctions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		resp.Functions = append(resp.Functions, tfprotov5.FunctionMetadata{Name: name})
+	}
+	return resp, nil
+}
+
+func (f *functionServer) GetProviderSchema(
+	ctx context.Context,
+	req *tfprotov5.GetProviderSchemaRequest,
+) (*tfprotov5.GetProviderSchemaResponse, error) {
+	resp, err := f.ProviderServer.GetProviderSchema(ctx, req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+	resp.Functions = functionDefinitions()
+	return resp, nil
+}
+
+func (f *functionServer) GetFunctions(
+	_ context.Context,
+	_ *tfprotov5.GetFunctionsRequest,
+) (*tfprotov5.GetFunctionsResponse, error) {
+	return &tfprotov5.GetFunctionsResponse{
+		Functions: functionDefinitions(),
+	}, nil
+}
+
+func (f *functionServer) CallFunction(
+	_ context.Context,
+	req *tfprotov5.CallFunctionRequest,
+) (*tfprotov5.CallFunctionResponse, error) {
+	function, ok := providerFunctions[req.Name]
+	if !ok {
+		return &tfprotov5.CallFunctionResponse{
+			Error: &tfprotov5.FunctionError{
+				Text: fmt.Sprintf("Function Not Found: No function named %q was found in the provider.", req.Name),
+			},
+		}, nil
+	}
+	parameters := function.definition.Parameters
+	if len(req.Arguments) != len(parameters) {
+		return &tfprotov5.CallFunctionResponse{
+			Error: &tfprotov5.FunctionError{
+				Text: fmt.Sprintf(
+					"Function %s expects %d arguments, got %d.",
+					req.Name,
+					len(parameters),
+					len(req.Arguments),
+				),
+			},
+		}, nil
+	}
+	args := make([]tftypes.Value, len(req.Arguments))
+	for i, argument := range req.Arguments {
+		value, err := argument.Unmarshal(parameters[i].Type)
+		if err != nil {
+			return &tfprotov5.CallFunctionResponse{Error: functionArgumentError(i, err)}, nil
+		}
+		args[i] = value
+	}
+	result, funcErr := function.call(args)
+	if funcErr != nil {
+		return &tfprotov5.CallFunctionResponse{Error: funcErr}, nil
+	}
+	dynamicValue, err := tfprotov5.NewDynamicValue(function.definition.Return.Type, result)
+	if err != nil {
+		return &tfprotov5.CallFunctionResponse{
+			Error: &tfprotov5.FunctionError{
+				Text: fmt.Sprintf("Failed to encode the result of function %s (%v)", req.Name, err),
+			},
+		}, nil
+	}
+	return &tfprotov5.CallFunctionResponse{Result: &dynamicValue}, nil
+}
+
+func functionDefinitions() map[string]*tfprotov5.Function {
+	definitions := make(map[string]*tfprotov5.Function, len(providerFunctions))
+	for name, function := range providerFunctions {
+		definitions[name] = function.definition
+	}
+	return definitions
+}
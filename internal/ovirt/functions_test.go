@@ -0,0 +1,123 @@
+package ovirt
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func callTestFunction(t *testing.T, server tfprotov5.ProviderServer, name string, args ...tftypes.Value) (
+	tftypes.Value,
+	*tfprotov5.FunctionError,
+) {
+	t.Helper()
+	arguments := make([]*tfprotov5.DynamicValue, len(args))
+	for i, arg := range args {
+		value, err := tfprotov5.NewDynamicValue(arg.Type(), arg)
+		if err != nil {
+			t.Fatalf("failed to encode argument %d of %s (%v)", i, name, err)
+		}
+		arguments[i] = &value
+	}
+	resp, err := server.CallFunction(context.Background(), &tfprotov5.CallFunctionRequest{Name: name, Arguments: arguments})
+	if err != nil {
+		t.Fatalf("failed to call %s (%v)", name, err)
+	}
+	if resp.Error != nil {
+		return tftypes.Value{}, resp.Error
+	}
+	result, err := resp.Result.Unmarshal(providerFunctions[name].definition.Return.Type)
+	if err != nil {
+		t.Fatalf("failed to decode result of %s (%v)", name, err)
+	}
+	return result, nil
+}
+
+func TestFunctions(t *testing.T) {
+	t.Parallel()
+
+	server := WithFunctions(schema.NewGRPCProviderServer(newProvider(newTestLogger(t)).getProvider()))
+
+	schemaResp, err := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("failed to get provider schema (%v)", err)
+	}
+	if _, ok := schemaResp.ResourceSchemas["ovirt_vm"]; !ok {
+		t.Fatalf("resources are missing from the provider schema")
+	}
+	for name := range providerFunctions {
+		if _, ok := schemaResp.Functions[name]; !ok {
+			t.Fatalf("function %s is missing from the provider schema", name)
+		}
+	}
+
+	result, funcErr := callTestFunction(t, server, "size_bytes", tftypes.NewValue(tftypes.String, "20GiB"))
+	if funcErr != nil {
+		t.Fatalf("size_bytes failed (%s)", funcErr.Text)
+	}
+	var size big.Float
+	if err := result.As(&size); err != nil || size.Cmp(big.NewFloat(21474836480)) != 0 {
+		t.Fatalf("incorrect result of size_bytes: %s", size.String())
+	}
+	if _, funcErr := callTestFunction(t, server, "size_bytes", tftypes.NewValue(tftypes.String, "20 gigs")); funcErr == nil {
+		t.Fatalf("size_bytes accepted an invalid size")
+	}
+
+	result, funcErr = callTestFunction(
+		t, server, "is_uuid", tftypes.NewValue(tftypes.String, "3b940b57-d3a5-448e-9bb3-0d73b76fbb08"),
+	)
+	var isUUID bool
+	if funcErr != nil || result.As(&isUUID) != nil || !isUUID {
+		t.Fatalf("is_uuid did not recognize a UUID")
+	}
+	result, _ = callTestFunction(t, server, "is_uuid", tftypes.NewValue(tftypes.String, "web-01"))
+	if result.As(&isUUID) != nil || isUUID {
+		t.Fatalf("is_uuid recognized a name as a UUID")
+	}
+
+	macRange := []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "56:6f:00:00:00:fe"),
+		tftypes.NewValue(tftypes.String, "56:6f:00:00:01:ff"),
+	}
+	result, funcErr = callTestFunction(t, server, "mac_from_pool", append(macRange, tftypes.NewValue(tftypes.Number, 3))...)
+	var mac string
+	if funcErr != nil || result.As(&mac) != nil || mac != "56:6f:00:00:01:01" {
+		t.Fatalf("incorrect result of mac_from_pool: %s", mac)
+	}
+	_, funcErr = callTestFunction(t, server, "mac_from_pool", append(macRange, tftypes.NewValue(tftypes.Number, 258))...)
+	if funcErr == nil || funcErr.FunctionArgument == nil || *funcErr.FunctionArgument != 2 {
+		t.Fatalf("mac_from_pool did not reject an index outside of the range")
+	}
+
+	result, funcErr = callTestFunction(
+		t,
+		server,
+		"cloud_init_network",
+		tftypes.NewValue(tftypes.String, "eth0"),
+		tftypes.NewValue(tftypes.String, "192.0.2.10/24"),
+		tftypes.NewValue(tftypes.String, "192.0.2.1"),
+	)
+	if funcErr != nil {
+		t.Fatalf("cloud_init_network failed (%s)", funcErr.Text)
+	}
+	if rendered := result.String(); !strings.Contains(rendered, `"192.0.2.10"`) ||
+		!strings.Contains(rendered, `"255.255.255.0"`) || !strings.Contains(rendered, `"eth0"`) {
+		t.Fatalf("incorrect result of cloud_init_network: %s", rendered)
+	}
+	_, funcErr = callTestFunction(
+		t,
+		server,
+		"cloud_init_network",
+		tftypes.NewValue(tftypes.String, "eth0"),
+		tftypes.NewValue(tftypes.String, "2001:db8::10/64"),
+		tftypes.NewValue(tftypes.String, "192.0.2.1"),
+	)
+	if funcErr == nil {
+		t.Fatalf("cloud_init_network accepted an IPv6 address")
+	}
+}
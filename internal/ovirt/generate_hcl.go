@@ -0,0 +1,434 @@
+package ovirt
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// GenerateHCL writes resource blocks and matching import blocks for the VMs in the engine, together with their disks,
+// disk attachments, NICs, tags and affinity groups. The query has the same format as the import queries of ovirt_vm
+// (for example cluster=prod or name=web-01,cluster=prod) and limits the output to the matching VMs and the objects
+// they use. An empty query generates the whole inventory.
+func GenerateHCL(client ovirtclient.Client, query string, output io.Writer) error {
+	parsedQuery := importQuery{}
+	if query != "" {
+		var err error
+		if parsedQuery, err = parseImportQuery(query, vmQueryKeys...); err != nil {
+			return err
+		}
+	}
+	vms, clusterNames, err := findVMs(client, parsedQuery)
+	if err != nil {
+		return err
+	}
+	sort.Slice(vms, func(i, j int) bool { return vms[i].Name() < vms[j].Name() })
+
+	g := &hclGenerator{
+		client:   client,
+		file:     hclwrite.NewEmptyFile(),
+		labels:   map[string]map[string]struct{}{},
+		vmLabels: map[ovirtclient.VMID]string{},
+	}
+	if err := g.generateTags(vms, len(parsedQuery) == 0); err != nil {
+		return err
+	}
+	if err := g.generateTemplateComments(vms, len(parsedQuery) == 0); err != nil {
+		return err
+	}
+	for _, vm := range vms {
+		if err := g.generateVM(vm); err != nil {
+			return err
+		}
+	}
+	clusterIDs := make([]ovirtclient.ClusterID, 0, len(clusterNames))
+	for clusterID := range clusterNames {
+		clusterIDs = append(clusterIDs, clusterID)
+	}
+	sort.Slice(clusterIDs, func(i, j int) bool { return clusterNames[clusterIDs[i]] < clusterNames[clusterIDs[j]] })
+	for _, clusterID := range clusterIDs {
+		if err := g.generateAffinityGroups(clusterID, len(parsedQuery) == 0); err != nil {
+			return err
+		}
+	}
+
+	if _, err := output.Write(append(bytes.TrimRight(g.file.Bytes(), "\n"), '\n')); err != nil {
+		return fmt.Errorf("failed to write generated configuration (%w)", err)
+	}
+	return nil
+}
+
+// hclGenerator keeps track of the generated resources so that they can reference each other.
+type hclGenerator struct {
+	client    ovirtclient.Client
+	file      *hclwrite.File
+	labels    map[string]map[string]struct{}
+	tagLabels map[ovirtclient.TagID]string
+	vmLabels  map[ovirtclient.VMID]string
+}
+
+var hclLabelInvalidCharacters = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// label returns a unique resource name for the resource type based on the name of the oVirt object.
+func (g *hclGenerator) label(resourceType string, name string) string {
+	base := strings.Trim(hclLabelInvalidCharacters.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if base == "" || (base[0] >= '0' && base[0] <= '9') {
+		base = strings.TrimPrefix(resourceType, "ovirt_") + "_" + base
+	}
+	used, ok := g.labels[resourceType]
+	if !ok {
+		used = map[string]struct{}{}
+		g.labels[resourceType] = used
+	}
+	label := base
+	for i := 2; ; i++ {
+		if _, ok := used[label]; !ok {
+			break
+		}
+		label = fmt.Sprintf("%s_%d", base, i)
+	}
+	used[label] = struct{}{}
+	return label
+}
+
+// addResource adds a resource block and an import block that adopts the existing object into it.
+func (g *hclGenerator) addResource(resourceType string, label string, importID string) *hclwrite.Body {
+	body := g.file.Body()
+	resourceBody := body.AppendNewBlock("resource", []string{resourceType, label}).Body()
+	body.AppendNewline()
+	importBody := body.AppendNewBlock("import", nil).Body()
+	importBody.SetAttributeTraversal("to", reference(resourceType, label))
+	importBody.SetAttributeValue("id", cty.StringVal(importID))
+	body.AppendNewline()
+	return resourceBody
+}
+
+// reference returns a reference to a generated resource, or to one of its attributes.
+func reference(resourceType string, label string, attribute ...string) hcl.Traversal {
+	traversal := hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: label},
+	}
+	for _, attr := range attribute {
+		traversal = append(traversal, hcl.TraverseAttr{Name: attr})
+	}
+	return traversal
+}
+
+func (g *hclGenerator) comment(lines ...string) {
+	tokens := make(hclwrite.Tokens, len(lines))
+	for i, line := range lines {
+		tokens[i] = &hclwrite.Token{
+			Type:  hclsyntax.TokenComment,
+			Bytes: []byte("# " + line + "\n"),
+		}
+	}
+	g.file.Body().AppendUnstructuredTokens(tokens)
+	g.file.Body().AppendNewline()
+}
+
+// generateTags generates the tags attached to the VMs, or all tags if all is set.
+func (g *hclGenerator) generateTags(vms []ovirtclient.VM, all bool) error {
+	g.tagLabels = map[ovirtclient.TagID]string{}
+	var tags []ovirtclient.Tag
+	if all {
+		var err error
+		if tags, err = g.client.ListTags(); err != nil {
+			return fmt.Errorf("failed to list tags (%w)", err)
+		}
+	} else {
+		seen := map[ovirtclient.TagID]struct{}{}
+		for _, vm := range vms {
+			vmTags, err := g.client.ListVMTags(vm.ID())
+			if err != nil {
+				return fmt.Errorf("failed to list tags of VM %s (%w)", vm.Name(), err)
+			}
+			for _, tag := range vmTags {
+				if _, ok := seen[tag.ID()]; !ok {
+					seen[tag.ID()] = struct{}{}
+					tags = append(tags, tag)
+				}
+			}
+		}
+	}
+	sort.Slice(tags, func(i, j int) bool { return tags[i].Name() < tags[j].Name() })
+	for _, tag := range tags {
+		label := g.label("ovirt_tag", tag.Name())
+		g.tagLabels[tag.ID()] = label
+		body := g.addResource("ovirt_tag", label, string(tag.ID()))
+		body.SetAttributeValue("name", cty.StringVal(tag.Name()))
+		if description := tag.Description(); description != nil && *description != "" {
+			body.SetAttributeValue("description", cty.StringVal(*description))
+		}
+	}
+	return nil
+}
+
+// generateTemplateComments lists the templates the VMs are based on, or all templates if all is set. Templates are
+// not generated as resources because ovirt_template requires the VM the template was created from, which the engine
+// doesn't record.
+func (g *hclGenerator) generateTemplateComments(vms []ovirtclient.VM, all bool) error {
+	templates, err := g.client.ListTemplates()
+	if err != nil {
+		return fmt.Errorf("failed to list templates (%w)", err)
+	}
+	used := map[ovirtclient.TemplateID]struct{}{}
+	for _, vm := range vms {
+		used[vm.TemplateID()] = struct{}{}
+	}
+	var lines []string
+	for _, template := range templates {
+		if _, ok := used[template.ID()]; !ok && !all {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("  %s: %s", template.ID(), template.Name()))
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+	sort.Strings(lines)
+	g.comment(
+		append(
+			[]string{
+				"Templates cannot be adopted as ovirt_template resources because the engine doesn't record the VM they",
+				"were created from. The generated VMs refer to the following templates by ID:",
+			},
+			lines...,
+		)...,
+	)
+	return nil
+}
+
+func (g *hclGenerator) generateVM(vm ovirtclient.VM) error {
+	vmLabel := g.label("ovirt_vm", vm.Name())
+	g.vmLabels[vm.ID()] = vmLabel
+	body := g.addResource("ovirt_vm", vmLabel, string(vm.ID()))
+	body.SetAttributeValue("name", cty.StringVal(vm.Name()))
+	body.SetAttributeValue("cluster_id", cty.StringVal(string(vm.ClusterID())))
+	body.SetAttributeValue("template_id", cty.StringVal(string(vm.TemplateID())))
+	if comment := vm.Comment(); comment != "" {
+		body.SetAttributeValue("comment", cty.StringVal(comment))
+	}
+	if osType := vm.OS().Type(); osType != "" && osType != "other" {
+		body.SetAttributeValue("os_type", cty.StringVal(osType))
+	}
+	if pp, ok := vm.PlacementPolicy(); ok {
+		hostIDs := make([]cty.Value, len(pp.HostIDs()))
+		for i, hostID := range pp.HostIDs() {
+			hostIDs[i] = cty.StringVal(string(hostID))
+		}
+		if affinity := pp.Affinity(); affinity != nil && len(hostIDs) != 0 {
+			body.SetAttributeValue("placement_policy_affinity", cty.StringVal(string(*affinity)))
+			body.SetAttributeValue("placement_policy_host_ids", cty.SetVal(hostIDs))
+		}
+	}
+
+	attachments, err := g.client.ListDiskAttachments(vm.ID())
+	if err != nil {
+		return fmt.Errorf("failed to list disk attachments of VM %s (%w)", vm.Name(), err)
+	}
+	disks := make([]ovirtclient.Disk, len(attachments))
+	for i, attachment := range attachments {
+		disks[i], err = g.client.GetDisk(attachment.DiskID())
+		if err != nil {
+			return fmt.Errorf("failed to fetch disk %s of VM %s (%w)", attachment.DiskID(), vm.Name(), err)
+		}
+	}
+	fromTemplate, err := g.templateDisks(vm, attachments, disks)
+	if err != nil {
+		return err
+	}
+	for i, attachment := range attachments {
+		disk := disks[i]
+		if fromTemplate[i] {
+			g.comment(
+				fmt.Sprintf(
+					"Disk %s of VM %s was created from template %s and is managed by ovirt_vm.%s.",
+					disk.ID(),
+					vm.Name(),
+					vm.TemplateID(),
+					vmLabel,
+				),
+			)
+			continue
+		}
+		diskName := disk.Alias()
+		if diskName == "" {
+			diskName = vm.Name() + "_disk"
+		}
+		diskLabel := g.label("ovirt_disk", diskName)
+		diskBody := g.addResource("ovirt_disk", diskLabel, string(disk.ID()))
+		if len(disk.StorageDomainIDs()) != 0 {
+			diskBody.SetAttributeValue("storage_domain_id", cty.StringVal(string(disk.StorageDomainIDs()[0])))
+		}
+		diskBody.SetAttributeValue("format", cty.StringVal(string(disk.Format())))
+		diskBody.SetAttributeValue("size", cty.StringVal(humanSize(disk.ProvisionedSize())))
+		if disk.Alias() != "" {
+			diskBody.SetAttributeValue("alias", cty.StringVal(disk.Alias()))
+		}
+		diskBody.SetAttributeValue("sparse", cty.BoolVal(disk.Sparse()))
+
+		attachmentBody := g.addResource(
+			"ovirt_disk_attachment",
+			g.label("ovirt_disk_attachment", vmLabel+"_"+diskLabel),
+			fmt.Sprintf("%s/%s", vm.ID(), attachment.ID()),
+		)
+		attachmentBody.SetAttributeTraversal("vm_id", reference("ovirt_vm", vmLabel, "id"))
+		attachmentBody.SetAttributeTraversal("disk_id", reference("ovirt_disk", diskLabel, "id"))
+		attachmentBody.SetAttributeValue("disk_interface", cty.StringVal(string(attachment.DiskInterface())))
+		attachmentBody.SetAttributeValue("bootable", cty.BoolVal(attachment.Bootable()))
+		attachmentBody.SetAttributeValue("active", cty.BoolVal(attachment.Active()))
+	}
+
+	nics, err := g.client.ListNICs(vm.ID())
+	if err != nil {
+		return fmt.Errorf("failed to list NICs of VM %s (%w)", vm.Name(), err)
+	}
+	for _, nic := range nics {
+		nicBody := g.addResource(
+			"ovirt_nic",
+			g.label("ovirt_nic", vmLabel+"_"+nic.Name()),
+			fmt.Sprintf("%s/%s", vm.ID(), nic.ID()),
+		)
+		nicBody.SetAttributeTraversal("vm_id", reference("ovirt_vm", vmLabel, "id"))
+		nicBody.SetAttributeValue("name", cty.StringVal(nic.Name()))
+		nicBody.SetAttributeValue("vnic_profile_id", cty.StringVal(string(nic.VNICProfileID())))
+		if mac := nic.Mac(); mac != "" {
+			nicBody.SetAttributeValue("mac", cty.StringVal(mac))
+		}
+	}
+
+	tags, err := g.client.ListVMTags(vm.ID())
+	if err != nil {
+		return fmt.Errorf("failed to list tags of VM %s (%w)", vm.Name(), err)
+	}
+	for _, tag := range tags {
+		tagLabel := g.tagLabels[tag.ID()]
+		tagBody := g.addResource(
+			"ovirt_vm_tag",
+			g.label("ovirt_vm_tag", vmLabel+"_"+tagLabel),
+			fmt.Sprintf("%s_%s", tag.ID(), vm.ID()),
+		)
+		tagBody.SetAttributeTraversal("tag_id", reference("ovirt_tag", tagLabel, "id"))
+		tagBody.SetAttributeTraversal("vm_id", reference("ovirt_vm", vmLabel, "id"))
+	}
+	return nil
+}
+
+// templateDisks reports which disk attachments of the VM were created from the disks of its template. These disks are
+// created together with the VM by ovirt_vm, so they must not be generated as separate resources. The engine doesn't
+// record which template disk a VM disk was copied from, so the attachments are matched by their interface and
+// bootable flag, preferring disks that still have the size and format of the template disk.
+func (g *hclGenerator) templateDisks(
+	vm ovirtclient.VM,
+	attachments []ovirtclient.DiskAttachment,
+	disks []ovirtclient.Disk,
+) ([]bool, error) {
+	fromTemplate := make([]bool, len(attachments))
+	templateAttachments, err := g.client.ListTemplateDiskAttachments(vm.TemplateID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list disks of template %s of VM %s (%w)", vm.TemplateID(), vm.Name(), err)
+	}
+	templateDisks := make([]ovirtclient.Disk, len(templateAttachments))
+	for i, templateAttachment := range templateAttachments {
+		templateDisks[i], err = g.client.GetDisk(templateAttachment.DiskID())
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to fetch disk %s of template %s (%w)",
+				templateAttachment.DiskID(),
+				vm.TemplateID(),
+				err,
+			)
+		}
+	}
+	matched := make([]bool, len(templateAttachments))
+	for _, exact := range []bool{true, false} {
+		for i, templateAttachment := range templateAttachments {
+			if matched[i] {
+				continue
+			}
+			for j, attachment := range attachments {
+				if fromTemplate[j] ||
+					attachment.DiskInterface() != templateAttachment.DiskInterface() ||
+					attachment.Bootable() != templateAttachment.Bootable() {
+					continue
+				}
+				if exact && (disks[j].ProvisionedSize() != templateDisks[i].ProvisionedSize() ||
+					disks[j].Format() != templateDisks[i].Format()) {
+					continue
+				}
+				matched[i] = true
+				fromTemplate[j] = true
+				break
+			}
+		}
+	}
+	return fromTemplate, nil
+}
+
+// generateAffinityGroups generates the affinity groups of a cluster that contain one of the generated VMs, or all
+// affinity groups if all is set, together with the assignments of the generated VMs to them.
+func (g *hclGenerator) generateAffinityGroups(clusterID ovirtclient.ClusterID, all bool) error {
+	groups, err := g.client.ListAffinityGroups(clusterID)
+	if err != nil {
+		return fmt.Errorf("failed to list affinity groups in cluster %s (%w)", clusterID, err)
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Name() < groups[j].Name() })
+	for _, group := range groups {
+		var members []ovirtclient.VMID
+		for _, vmID := range group.VMIDs() {
+			if _, ok := g.vmLabels[vmID]; ok {
+				members = append(members, vmID)
+			}
+		}
+		if len(members) == 0 && !all {
+			continue
+		}
+
+		groupLabel := g.label("ovirt_affinity_group", group.Name())
+		body := g.addResource("ovirt_affinity_group", groupLabel, fmt.Sprintf("%s/%s", clusterID, group.ID()))
+		body.SetAttributeValue("cluster_id", cty.StringVal(string(clusterID)))
+		body.SetAttributeValue("name", cty.StringVal(group.Name()))
+		if group.Description() != "" {
+			body.SetAttributeValue("description", cty.StringVal(group.Description()))
+		}
+		body.SetAttributeValue("enforcing", cty.BoolVal(group.Enforcing()))
+		for _, rule := range []struct {
+			name string
+			rule ovirtclient.AffinityRule
+		}{
+			{"hosts_rule", group.HostsRule()},
+			{"vms_rule", group.VMsRule()},
+		} {
+			if !rule.rule.Enabled() {
+				continue
+			}
+			ruleBody := body.AppendNewBlock(rule.name, nil).Body()
+			ruleBody.SetAttributeValue("affinity", cty.StringVal(convertAffinity(rule.rule.Affinity())))
+			ruleBody.SetAttributeValue("enforcing", cty.BoolVal(rule.rule.Enforcing()))
+		}
+
+		sort.Slice(members, func(i, j int) bool { return g.vmLabels[members[i]] < g.vmLabels[members[j]] })
+		for _, vmID := range members {
+			vmLabel := g.vmLabels[vmID]
+			assignmentBody := g.addResource(
+				"ovirt_vm_affinity_group",
+				g.label("ovirt_vm_affinity_group", vmLabel+"_"+groupLabel),
+				fmt.Sprintf("%s/%s/%s", clusterID, group.ID(), vmID),
+			)
+			assignmentBody.SetAttributeValue("cluster_id", cty.StringVal(string(clusterID)))
+			assignmentBody.SetAttributeTraversal("vm_id", reference("ovirt_vm", vmLabel, "id"))
+			assignmentBody.SetAttributeTraversal("affinity_group_id", reference("ovirt_affinity_group", groupLabel, "id"))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package ovirt
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestGenerateHCL(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(ovirtclientlog.NewTestLogger(t))
+	helper := p.getTestHelper()
+	client := helper.GetClient()
+
+	vm, err := client.CreateVM(helper.GetClusterID(), helper.GetBlankTemplateID(), "web-01", nil)
+	if err != nil {
+		t.Fatalf("failed to create test VM (%v)", err)
+	}
+	if _, err := client.CreateVM(helper.GetClusterID(), helper.GetBlankTemplateID(), "db-01", nil); err != nil {
+		t.Fatalf("failed to create test VM (%v)", err)
+	}
+	diskParams, err := ovirtclient.CreateDiskParams().WithAlias("web-01-data")
+	if err != nil {
+		t.Fatalf("failed to set disk alias (%v)", err)
+	}
+	disk, err := client.CreateDisk(helper.GetStorageDomainID(), ovirtclient.ImageFormatRaw, 1048576, diskParams)
+	if err != nil {
+		t.Fatalf("failed to create test disk (%v)", err)
+	}
+	attachment, err := client.CreateDiskAttachment(vm.ID(), disk.ID(), ovirtclient.DiskInterfaceVirtIO, nil)
+	if err != nil {
+		t.Fatalf("failed to attach test disk (%v)", err)
+	}
+	nicParams, err := ovirtclient.CreateNICParams().WithMac("00:1a:4a:16:01:51")
+	if err != nil {
+		t.Fatalf("failed to set NIC MAC address (%v)", err)
+	}
+	if _, err := client.CreateNIC(vm.ID(), helper.GetVNICProfileID(), "eth0", nicParams); err != nil {
+		t.Fatalf("failed to create test NIC (%v)", err)
+	}
+	tag, err := client.CreateTag("web", nil)
+	if err != nil {
+		t.Fatalf("failed to create test tag (%v)", err)
+	}
+	if err := client.AddTagToVM(vm.ID(), tag.ID()); err != nil {
+		t.Fatalf("failed to attach test tag (%v)", err)
+	}
+	group, err := client.CreateAffinityGroup(helper.GetClusterID(), "web-spread", nil)
+	if err != nil {
+		t.Fatalf("failed to create test affinity group (%v)", err)
+	}
+	if err := client.AddVMToAffinityGroup(helper.GetClusterID(), vm.ID(), group.ID()); err != nil {
+		t.Fatalf("failed to add test VM to affinity group (%v)", err)
+	}
+
+	output := &bytes.Buffer{}
+	if err := GenerateHCL(client, "name=web-01", output); err != nil {
+		t.Fatalf("failed to generate HCL (%v)", err)
+	}
+	if _, diags := hclwrite.ParseConfig(output.Bytes(), "generated.tf", hcl.InitialPos); diags.HasErrors() {
+		t.Fatalf("generated configuration is invalid (%v):\n%s", diags, output)
+	}
+	generated := output.String()
+	for _, expected := range []string{
+		`resource "ovirt_vm" "web_01"`,
+		`to = ovirt_vm.web_01`,
+		fmt.Sprintf(`id = "%s"`, vm.ID()),
+		`resource "ovirt_disk" "web_01_data"`,
+		fmt.Sprintf(`id = "%s/%s"`, vm.ID(), attachment.ID()),
+		`disk_id        = ovirt_disk.web_01_data.id`,
+		`mac             = "00:1a:4a:16:01:51"`,
+		`resource "ovirt_tag" "web"`,
+		fmt.Sprintf(`id = "%s_%s"`, tag.ID(), vm.ID()),
+		`resource "ovirt_affinity_group" "web_spread"`,
+		fmt.Sprintf(`id = "%s/%s/%s"`, helper.GetClusterID(), group.ID(), vm.ID()),
+	} {
+		if !strings.Contains(generated, expected) {
+			t.Fatalf("generated configuration doesn't contain %q:\n%s", expected, generated)
+		}
+	}
+	if strings.Contains(generated, "db-01") {
+		t.Fatalf("generated configuration contains a VM not matching the query:\n%s", generated)
+	}
+}
+
+func TestGenerateHCLTemplateDisks(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(ovirtclientlog.NewTestLogger(t))
+	helper := p.getTestHelper()
+	client := helper.GetClient()
+
+	base, err := client.CreateVM(helper.GetClusterID(), helper.GetBlankTemplateID(), "base", nil)
+	if err != nil {
+		t.Fatalf("failed to create test VM (%v)", err)
+	}
+	templateDisk, err := client.CreateDisk(helper.GetStorageDomainID(), ovirtclient.ImageFormatRaw, 1048576, nil)
+	if err != nil {
+		t.Fatalf("failed to create test disk (%v)", err)
+	}
+	if _, err := client.CreateDiskAttachment(base.ID(), templateDisk.ID(), ovirtclient.DiskInterfaceVirtIO, nil); err != nil {
+		t.Fatalf("failed to attach test disk (%v)", err)
+	}
+	tpl, err := client.CreateTemplate(base.ID(), "base", nil)
+	if err != nil {
+		t.Fatalf("failed to create test template (%v)", err)
+	}
+	if _, err := client.WaitForTemplateStatus(tpl.ID(), ovirtclient.TemplateStatusOK); err != nil {
+		t.Fatalf("failed to wait for test template (%v)", err)
+	}
+	vm, err := client.CreateVM(helper.GetClusterID(), tpl.ID(), "app-01", nil)
+	if err != nil {
+		t.Fatalf("failed to create test VM from template (%v)", err)
+	}
+	diskParams, err := ovirtclient.CreateDiskParams().WithAlias("app-01-data")
+	if err != nil {
+		t.Fatalf("failed to set disk alias (%v)", err)
+	}
+	disk, err := client.CreateDisk(helper.GetStorageDomainID(), ovirtclient.ImageFormatRaw, 1048576, diskParams)
+	if err != nil {
+		t.Fatalf("failed to create test disk (%v)", err)
+	}
+	if _, err := client.CreateDiskAttachment(vm.ID(), disk.ID(), ovirtclient.DiskInterfaceVirtIO, nil); err != nil {
+		t.Fatalf("failed to attach test disk (%v)", err)
+	}
+
+	output := &bytes.Buffer{}
+	if err := GenerateHCL(client, "name=app-01", output); err != nil {
+		t.Fatalf("failed to generate HCL (%v)", err)
+	}
+	generated := output.String()
+	if count := strings.Count(generated, `resource "ovirt_disk" `); count != 1 {
+		t.Fatalf("expected only the disk not created from the template to be generated, got %d:\n%s", count, generated)
+	}
+	if !strings.Contains(generated, `resource "ovirt_disk" "app_01_data"`) {
+		t.Fatalf("generated configuration doesn't contain the disk added to the VM:\n%s", generated)
+	}
+	if !strings.Contains(generated, "was created from template") {
+		t.Fatalf("generated configuration doesn't mention the disk created from the template:\n%s", generated)
+	}
+}
@@ -0,0 +1,257 @@
+package ovirt
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+// importQuery is an import ID of the form key=value[,key=value...] that identifies an object by its properties
+// instead of its ID, for example name=web-01,cluster=prod.
+type importQuery map[string]string
+
+// isImportQuery returns true if the import ID is a query rather than an ID. oVirt IDs never contain an equals sign.
+func isImportQuery(importID string) bool {
+	return strings.Contains(importID, "=")
+}
+
+// parseImportQuery parses an import query and checks that it only uses the supported keys.
+func parseImportQuery(importID string, supportedKeys ...string) (importQuery, error) {
+	query := importQuery{}
+	for _, part := range strings.Split(importID, ",") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 || kv[0] == "" || kv[1] == "" {
+			return nil, fmt.Errorf(
+				"invalid import query %q, the query must be in the form key=value[,key=value...]",
+				importID,
+			)
+		}
+		key := strings.TrimSpace(kv[0])
+		if key == "search" {
+			return nil, fmt.Errorf(
+				"free-form oVirt search expressions are not supported by the oVirt client library used by this provider, please use the following keys instead: %s",
+				strings.Join(supportedKeys, ", "),
+			)
+		}
+		found := false
+		for _, supportedKey := range supportedKeys {
+			if key == supportedKey {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf(
+				"unsupported key %q in import query %q, supported keys are: %s",
+				key,
+				importID,
+				strings.Join(supportedKeys, ", "),
+			)
+		}
+		if _, ok := query[key]; ok {
+			return nil, fmt.Errorf("duplicate key %q in import query %q", key, importID)
+		}
+		query[key] = strings.TrimSpace(kv[1])
+	}
+	return query, nil
+}
+
+func (q importQuery) String() string {
+	parts := make([]string, 0, len(q))
+	for key, value := range q {
+		parts = append(parts, fmt.Sprintf("%s=%s", key, value))
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
+
+// importCandidate is an object matching an import query.
+type importCandidate struct {
+	id          string
+	description string
+}
+
+// selectImportCandidate returns the ID of the only candidate, or an error listing the candidates if the query is
+// ambiguous.
+func selectImportCandidate(objectType string, query importQuery, candidates []importCandidate) (string, error) {
+	switch len(candidates) {
+	case 0:
+		return "", fmt.Errorf("no %s matches the import query %s", objectType, query)
+	case 1:
+		return candidates[0].id, nil
+	}
+	lines := make([]string, len(candidates))
+	for i, candidate := range candidates {
+		lines[i] = fmt.Sprintf("  - %s (ID: %s)", candidate.description, candidate.id)
+	}
+	sort.Strings(lines)
+	return "", fmt.Errorf(
+		"%d objects of type %s match the import query %s, please narrow down the query or import by ID; candidates:\n%s",
+		len(candidates),
+		objectType,
+		query,
+		strings.Join(lines, "\n"),
+	)
+}
+
+// findVMForImport resolves a VM import query with the name and cluster keys. The cluster may be specified by name or
+// ID.
+func findVMForImport(client ovirtclient.Client, importID string) (ovirtclient.VMID, error) {
+	query, err := parseImportQuery(importID, vmQueryKeys...)
+	if err != nil {
+		return "", err
+	}
+	vms, clusterNames, err := findVMs(client, query)
+	if err != nil {
+		return "", err
+	}
+	candidates := make([]importCandidate, len(vms))
+	for i, vm := range vms {
+		candidates[i] = importCandidate{
+			id:          string(vm.ID()),
+			description: fmt.Sprintf("VM %s in cluster %s", vm.Name(), clusterNames[vm.ClusterID()]),
+		}
+	}
+	id, err := selectImportCandidate("VM", query, candidates)
+	return ovirtclient.VMID(id), err
+}
+
+// vmQueryKeys are the keys supported in queries for VMs.
+var vmQueryKeys = []string{"name", "cluster"}
+
+// findVMs returns the VMs matching a query with the keys in vmQueryKeys, as well as the names of all clusters by ID.
+// An empty query matches all VMs.
+func findVMs(client ovirtclient.Client, query importQuery) (
+	[]ovirtclient.VM,
+	map[ovirtclient.ClusterID]string,
+	error,
+) {
+	var vms []ovirtclient.VM
+	var err error
+	if name, ok := query["name"]; ok {
+		vms, err = client.SearchVMs(ovirtclient.VMSearchParams().WithName(name))
+	} else {
+		vms, err = client.ListVMs()
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to search VMs for query %s (%w)", query, err)
+	}
+	clusters, err := client.ListClusters()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list clusters for query %s (%w)", query, err)
+	}
+	clusterNames := make(map[ovirtclient.ClusterID]string, len(clusters))
+	for _, cluster := range clusters {
+		clusterNames[cluster.ID()] = cluster.Name()
+	}
+
+	var result []ovirtclient.VM
+	for _, vm := range vms {
+		if cluster, ok := query["cluster"]; ok && cluster != string(vm.ClusterID()) && cluster != clusterNames[vm.ClusterID()] {
+			continue
+		}
+		result = append(result, vm)
+	}
+	return result, clusterNames, nil
+}
+
+// findTemplateForImport resolves a template import query with the name key.
+func findTemplateForImport(client ovirtclient.Client, importID string) (ovirtclient.TemplateID, error) {
+	query, err := parseImportQuery(importID, "name")
+	if err != nil {
+		return "", err
+	}
+	templates, err := client.ListTemplates()
+	if err != nil {
+		return "", fmt.Errorf("failed to list templates for import query %s (%w)", query, err)
+	}
+	var candidates []importCandidate
+	for _, template := range templates {
+		if template.Name() != query["name"] {
+			continue
+		}
+		candidates = append(
+			candidates, importCandidate{
+				id:          string(template.ID()),
+				description: fmt.Sprintf("template %s (%s)", template.Name(), template.Description()),
+			},
+		)
+	}
+	id, err := selectImportCandidate("template", query, candidates)
+	return ovirtclient.TemplateID(id), err
+}
+
+// findDiskForImport resolves a disk import query with the name key, which matches the disk alias.
+func findDiskForImport(client ovirtclient.Client, importID string) (ovirtclient.DiskID, error) {
+	query, err := parseImportQuery(importID, "name")
+	if err != nil {
+		return "", err
+	}
+	disks, err := client.ListDisksByAlias(query["name"])
+	if err != nil {
+		return "", fmt.Errorf("failed to list disks for import query %s (%w)", query, err)
+	}
+	candidates := make([]importCandidate, len(disks))
+	for i, disk := range disks {
+		candidates[i] = importCandidate{
+			id:          string(disk.ID()),
+			description: fmt.Sprintf("disk %s with %d bytes", disk.Alias(), disk.ProvisionedSize()),
+		}
+	}
+	id, err := selectImportCandidate("disk", query, candidates)
+	return ovirtclient.DiskID(id), err
+}
+
+// findDiskAttachmentForImport resolves a disk attachment import query with the name key, which matches the alias of
+// the attached disk, among the disk attachments of a VM.
+func findDiskAttachmentForImport(
+	client ovirtclient.Client,
+	vmID ovirtclient.VMID,
+	importID string,
+) (ovirtclient.DiskAttachmentID, error) {
+	query, err := parseImportQuery(importID, "name")
+	if err != nil {
+		return "", err
+	}
+	attachments, err := client.ListDiskAttachments(vmID)
+	if err != nil {
+		return "", fmt.Errorf("failed to list disk attachments of VM %s for import query %s (%w)", vmID, query, err)
+	}
+	var candidates []importCandidate
+	for _, attachment := range attachments {
+		disk, err := client.GetDisk(attachment.DiskID())
+		if err != nil {
+			return "", fmt.Errorf("failed to fetch disk %s for import query %s (%w)", attachment.DiskID(), query, err)
+		}
+		if disk.Alias() != query["name"] {
+			continue
+		}
+		candidates = append(
+			candidates, importCandidate{
+				id:          string(attachment.ID()),
+				description: fmt.Sprintf("attachment of disk %s (ID: %s)", disk.Alias(), disk.ID()),
+			},
+		)
+	}
+	id, err := selectImportCandidate("disk attachment", query, candidates)
+	return ovirtclient.DiskAttachmentID(id), err
+}
+
+// splitImportID splits a composite import ID, such as ClusterID/AffinityGroupID, into its non-empty parts. The format
+// is shown to the user if the import ID doesn't match it.
+func splitImportID(importID string, separator string, format string) ([]string, error) {
+	count := len(strings.Split(format, separator))
+	parts := strings.SplitN(importID, separator, count)
+	valid := len(parts) == count
+	for _, part := range parts {
+		if part == "" {
+			valid = false
+		}
+	}
+	if !valid {
+		return nil, fmt.Errorf("invalid import ID %q, the ID should be specified as: %s", importID, format)
+	}
+	return parts, nil
+}
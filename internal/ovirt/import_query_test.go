@@ -0,0 +1,121 @@
+package ovirt
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+)
+
+func TestVMResourceImportByName(t *testing.T) {
+	t.Parallel()
+
+	// Special case: we are using the ovirtclientlog.NewTestLogger here because we call the client methods outside of
+	// the Terraform context.
+	p := newProvider(ovirtclientlog.NewTestLogger(t))
+	client := p.getTestHelper().GetClient()
+	clusterID := p.getTestHelper().GetClusterID()
+	templateID := p.getTestHelper().GetBlankTemplateID()
+	name := p.getTestHelper().GenerateTestResourceName(t)
+
+	config := fmt.Sprintf(
+		`
+provider "ovirt" {
+	mock = true
+}
+
+resource "ovirt_vm" "foo" {
+	cluster_id  = "%s"
+	template_id = "%s"
+	name        = "%s"
+}
+`,
+		clusterID,
+		templateID,
+		name,
+	)
+
+	resource.UnitTest(
+		t, resource.TestCase{
+			ProviderFactories: p.getProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:       config,
+					ImportState:  true,
+					ResourceName: "ovirt_vm.foo",
+					ImportStateIdFunc: func(state *terraform.State) (string, error) {
+						if _, err := client.CreateVM(clusterID, templateID, name, nil); err != nil {
+							return "", fmt.Errorf("failed to create test VM (%w)", err)
+						}
+						return fmt.Sprintf("name=%s,cluster=%s", name, clusterID), nil
+					},
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestMatchResourceAttr(
+							"ovirt_vm.foo",
+							"name",
+							regexp.MustCompile(fmt.Sprintf("^%s$", regexp.QuoteMeta(name))),
+						),
+					),
+				},
+				{
+					Config:  config,
+					Destroy: true,
+				},
+			},
+		},
+	)
+}
+
+func TestFindVMForImport(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(ovirtclientlog.NewTestLogger(t))
+	helper := p.getTestHelper()
+	client := helper.GetClient()
+	name := helper.GenerateTestResourceName(t)
+	cluster, err := client.GetCluster(helper.GetClusterID())
+	if err != nil {
+		t.Fatalf("failed to fetch cluster (%v)", err)
+	}
+
+	vm, err := client.CreateVM(helper.GetClusterID(), helper.GetBlankTemplateID(), name, nil)
+	if err != nil {
+		t.Fatalf("failed to create test VM (%v)", err)
+	}
+	vmID, err := findVMForImport(client, fmt.Sprintf("name=%s,cluster=%s", name, cluster.Name()))
+	if err != nil {
+		t.Fatalf("failed to find VM by name and cluster name (%v)", err)
+	}
+	if vmID != vm.ID() {
+		t.Fatalf("incorrect VM ID (expected: %s, got: %s)", vm.ID(), vmID)
+	}
+
+	if _, err := findVMForImport(client, fmt.Sprintf("name=%s,cluster=nonexistent", name)); err == nil {
+		t.Fatalf("found a VM in a nonexistent cluster")
+	}
+
+	secondVM, err := client.CreateVM(helper.GetClusterID(), helper.GetBlankTemplateID(), name+"-2", nil)
+	if err != nil {
+		t.Fatalf("failed to create second test VM (%v)", err)
+	}
+	_, err = findVMForImport(client, fmt.Sprintf("cluster=%s", helper.GetClusterID()))
+	if err == nil {
+		t.Fatalf("ambiguous import query did not fail")
+	}
+	for _, id := range []string{string(vm.ID()), string(secondVM.ID())} {
+		if !strings.Contains(err.Error(), id) {
+			t.Fatalf("candidate %s is not listed in the error message: %v", id, err)
+		}
+	}
+
+	if _, err := findVMForImport(client, "search=name=web*"); err == nil {
+		t.Fatalf("free-form search query did not fail")
+	}
+	if _, err := findVMForImport(client, "status=up"); err == nil {
+		t.Fatalf("import query with an unsupported key did not fail")
+	}
+}
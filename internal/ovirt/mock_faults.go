@@ -0,0 +1,223 @@
+package ovirt
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+// mockFaultRule describes a fault injected into calls of a client method when running against the mock engine.
+type mockFaultRule struct {
+	method      string
+	errorCode   ovirtclient.ErrorCode
+	onCall      int
+	probability float64
+	latency     time.Duration
+}
+
+// mockFaultRules converts the mock_faults provider option into fault rules.
+func mockFaultRules(data *schema.ResourceData) []mockFaultRule {
+	rawRules := data.Get("mock_faults").([]interface{})
+	rules := make([]mockFaultRule, 0, len(rawRules))
+	for _, rawRule := range rawRules {
+		ruleConfig := rawRule.(map[string]interface{})
+		rule := mockFaultRule{
+			method:      ruleConfig["method"].(string),
+			errorCode:   ovirtclient.ErrorCode(ruleConfig["error_code"].(string)),
+			onCall:      ruleConfig["on_call"].(int),
+			probability: ruleConfig["probability"].(float64),
+		}
+		if latency := ruleConfig["latency"].(string); latency != "" {
+			// The value has already been checked by validateDuration.
+			rule.latency, _ = time.ParseDuration(latency)
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// mockFaultsHandler injects latency and errors into client calls according to the rules. Calls are counted per
+// method, starting at 1, and every attempt counts as a call. The mock client ignores retry strategies, so injected
+// errors are retried here with the retry strategies of the call, the same way the client library retries errors
+// returned by a live engine.
+func mockFaultsHandler(rules []mockFaultRule) clientCallHandler {
+	faults := &mockFaults{
+		rules:  rules,
+		calls:  map[string]int{},
+		random: rand.New(rand.NewSource(time.Now().UnixNano())), //nolint:gosec
+	}
+	return func(
+		client ovirtclient.Client,
+		method string,
+		retries []ovirtclient.RetryStrategy,
+		next clientCall,
+	) error {
+		action := fmt.Sprintf("calling %s", method)
+		instances := make([]ovirtclient.RetryInstance, 0, len(retries))
+		for _, retry := range mockFaultRetries(client, retries) {
+			instances = append(instances, retry.Get())
+		}
+		for {
+			err := faults.inject(client, method)
+			var fault *injectedFault
+			if !errors.As(err, &fault) {
+				if err != nil {
+					return err
+				}
+				return next(retries)
+			}
+			for _, instance := range instances {
+				if err := instance.Continue(err, action); err != nil {
+					return err
+				}
+			}
+			var waiting []ovirtclient.RetryInstance
+			var cases []reflect.SelectCase
+			for _, instance := range instances {
+				if c := instance.Wait(err); c != nil {
+					waiting = append(waiting, instance)
+					cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(c)})
+				}
+			}
+			if len(cases) == 0 {
+				return err
+			}
+			chosen, _, _ := reflect.Select(cases)
+			if err := waiting[chosen].OnWaitExpired(err, action); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// mockFaultRetries completes the retry strategies of a call with the defaults the client library uses for calls to a
+// live engine.
+func mockFaultRetries(client ovirtclient.Client, retries []ovirtclient.RetryStrategy) []ovirtclient.RetryStrategy {
+	canWait, canTimeout, canClassifyErrors := false, false, false
+	for _, retry := range retries {
+		canWait = canWait || retry.CanWait()
+		canTimeout = canTimeout || retry.CanTimeout()
+		canClassifyErrors = canClassifyErrors || retry.CanClassifyErrors()
+	}
+	if !canWait {
+		retries = append(retries, ovirtclient.ExponentialBackoff(2))
+	}
+	if !canTimeout {
+		retries = append(retries, ovirtclient.MaxTries(10))
+		if ctx := client.GetContext(); ctx != nil {
+			retries = append(retries, ovirtclient.ContextStrategy(ctx))
+		}
+	}
+	if !canClassifyErrors {
+		retries = append(retries, ovirtclient.AutoRetry())
+	}
+	return retries
+}
+
+// mockFaults holds the fault rules and the per-method call counters of mockFaultsHandler.
+type mockFaults struct {
+	lock   sync.Mutex
+	rules  []mockFaultRule
+	calls  map[string]int
+	random *rand.Rand
+}
+
+// inject counts an attempt to call the method and applies the matching rules. Each rule rolls its own random number,
+// so the probabilities of the rules are independent. It returns an *injectedFault if a rule injected an error.
+func (m *mockFaults) inject(client ovirtclient.Client, method string) error {
+	m.lock.Lock()
+	m.calls[method]++
+	call := m.calls[method]
+	m.lock.Unlock()
+
+	for _, rule := range m.rules {
+		if rule.method != method {
+			continue
+		}
+		if rule.latency > 0 {
+			var done <-chan struct{}
+			ctx := client.GetContext()
+			if ctx != nil {
+				done = ctx.Done()
+			}
+			select {
+			case <-time.After(rule.latency):
+			case <-done:
+				return fmt.Errorf("context canceled during injected latency for %s (%w)", method, ctx.Err())
+			}
+		}
+		if rule.errorCode == "" || (rule.onCall != 0 && rule.onCall != call) || m.roll() >= rule.probability {
+			continue
+		}
+		return &injectedFault{
+			code:    rule.errorCode,
+			message: fmt.Sprintf("injected fault on call %d of %s", call, method),
+		}
+	}
+	return nil
+}
+
+func (m *mockFaults) roll() float64 {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.random.Float64()
+}
+
+// clientMethodNames returns the names of all ovirtclient.Client methods that can be used in mock_faults rules.
+func clientMethodNames() []string {
+	clientType := reflect.TypeOf((*ovirtclient.Client)(nil)).Elem()
+	names := make([]string, 0, clientType.NumMethod())
+	for i := 0; i < clientType.NumMethod(); i++ {
+		method := clientType.Method(i)
+		if method.Type.IsVariadic() {
+			names = append(names, method.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// injectedFault is an ovirtclient.EngineError returned by mockFaultsHandler.
+type injectedFault struct {
+	code    ovirtclient.ErrorCode
+	message string
+}
+
+func (i *injectedFault) Error() string {
+	return fmt.Sprintf("%s: %s", i.code, i.message)
+}
+
+func (i *injectedFault) Message() string {
+	return i.message
+}
+
+func (i *injectedFault) String() string {
+	return i.Error()
+}
+
+func (i *injectedFault) HasCode(code ovirtclient.ErrorCode) bool {
+	return i.code == code
+}
+
+func (i *injectedFault) Code() ovirtclient.ErrorCode {
+	return i.code
+}
+
+func (i *injectedFault) Unwrap() error {
+	return nil
+}
+
+func (i *injectedFault) CanRecover() bool {
+	return i.code.CanRecover()
+}
+
+func (i *injectedFault) CanAutoRetry() bool {
+	return i.code.CanAutoRetry()
+}
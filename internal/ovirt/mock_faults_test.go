@@ -0,0 +1,134 @@
+package ovirt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func TestMockFaultsResource(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(newTestLogger(t))
+	config := fmt.Sprintf(
+		`
+provider "ovirt" {
+	mock = true
+
+	mock_faults {
+		method     = "CreateTag"
+		error_code = "bad_argument"
+	}
+}
+
+resource "ovirt_tag" "foo" {
+	name = "%s"
+}
+`,
+		fmt.Sprintf("%s-%s", t.Name(), p.getTestHelper().GenerateRandomID(5)),
+	)
+
+	resource.UnitTest(
+		t, resource.TestCase{
+			ProviderFactories: p.getProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      config,
+					ExpectError: regexp.MustCompile("injected fault"),
+				},
+			},
+		},
+	)
+}
+
+func TestMockFaultsHandler(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(newTestLogger(t))
+	client := wrapClient(
+		p.getTestHelper().GetClient(),
+		mockFaultsHandler(
+			[]mockFaultRule{
+				{
+					method:      "CreateTag",
+					errorCode:   ovirtclient.EBadArgument,
+					onCall:      2,
+					probability: 1,
+				},
+				{
+					method:      "ListTags",
+					errorCode:   ovirtclient.ENotFound,
+					probability: 0,
+					latency:     50 * time.Millisecond,
+				},
+			},
+		),
+	).WithContext(context.Background())
+
+	for i := 1; i <= 3; i++ {
+		name := fmt.Sprintf("%s-%s", t.Name(), p.getTestHelper().GenerateRandomID(5))
+		_, err := client.CreateTag(name, ovirtclient.NewCreateTagParams())
+		if i == 2 {
+			var engineErr ovirtclient.EngineError
+			if !errors.As(err, &engineErr) || !engineErr.HasCode(ovirtclient.EBadArgument) {
+				t.Fatalf("expected an injected bad argument error on call %d, got %v", i, err)
+			}
+		} else if err != nil {
+			t.Fatalf("unexpected error on call %d (%v)", i, err)
+		}
+	}
+
+	start := time.Now()
+	if _, err := client.ListTags(); err != nil {
+		t.Fatalf("fault with probability 0 was injected (%v)", err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Fatalf("latency was not injected, the call took %s", elapsed)
+	}
+}
+
+func TestMockFaultsHandlerRetries(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(newTestLogger(t))
+	client := wrapClient(
+		p.getTestHelper().GetClient(),
+		retryPolicyHandler(retryPolicy{maxAttempts: 2, backoffFactor: 1}),
+		mockFaultsHandler(
+			[]mockFaultRule{
+				{
+					method:      "CreateTag",
+					errorCode:   ovirtclient.EConflict,
+					onCall:      1,
+					probability: 1,
+				},
+				{
+					method:      "ListTags",
+					errorCode:   ovirtclient.EConflict,
+					probability: 1,
+				},
+			},
+		),
+	).WithContext(context.Background())
+
+	name := fmt.Sprintf("%s-%s", t.Name(), p.getTestHelper().GenerateRandomID(5))
+	if _, err := client.CreateTag(name, ovirtclient.NewCreateTagParams()); err != nil {
+		t.Fatalf("fault on the first call was not retried (%v)", err)
+	}
+
+	_, err := client.ListTags()
+	var engineErr ovirtclient.EngineError
+	if !errors.As(err, &engineErr) || !engineErr.HasCode(ovirtclient.EConflict) {
+		t.Fatalf("expected the injected conflict error after the retries were exhausted, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "call 3 of ListTags") {
+		t.Fatalf("expected the call to be given up after 2 retries, got %v", err)
+	}
+}
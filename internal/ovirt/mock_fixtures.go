@@ -0,0 +1,363 @@
+package ovirt
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+// mockFixtures describes the objects preloaded into the mock engine from the file in the mock_fixtures provider
+// option. ExportMockFixtures writes the same format from a live engine.
+type mockFixtures struct {
+	// The mock engine cannot create data centers, clusters, hosts, storage domains or networks. These sections are
+	// only decoded so that files containing them can be rejected with a clear error.
+	Datacenters    []json.RawMessage `json:"datacenters,omitempty"`
+	Clusters       []json.RawMessage `json:"clusters,omitempty"`
+	Hosts          []json.RawMessage `json:"hosts,omitempty"`
+	StorageDomains []json.RawMessage `json:"storage_domains,omitempty"`
+	Networks       []json.RawMessage `json:"networks,omitempty"`
+
+	VNICProfiles []mockFixtureVNICProfile `json:"vnic_profiles,omitempty"`
+	Templates    []mockFixtureTemplate    `json:"templates,omitempty"`
+	Tags         []mockFixtureTag         `json:"tags,omitempty"`
+}
+
+type mockFixtureVNICProfile struct {
+	Name    string `json:"name"`
+	Network string `json:"network,omitempty"`
+}
+
+type mockFixtureTemplate struct {
+	Name        string                    `json:"name"`
+	Description string                    `json:"description,omitempty"`
+	Disks       []mockFixtureTemplateDisk `json:"disks,omitempty"`
+}
+
+type mockFixtureTemplateDisk struct {
+	Size      uint64 `json:"size"`
+	Format    string `json:"format"`
+	Interface string `json:"interface"`
+	Bootable  bool   `json:"bootable,omitempty"`
+}
+
+type mockFixtureTag struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// loadMockFixtures creates the tags, VNIC profiles and templates described in the fixture file in the mock engine.
+// Objects that already exist with the same name are skipped, so the fixtures can be loaded more than once.
+func loadMockFixtures(client ovirtclient.Client, fixtureFile string) diag.Diagnostics {
+	contents, err := os.ReadFile(fixtureFile) //nolint:gosec
+	if err != nil {
+		return errorToDiags(fmt.Sprintf("read mock fixtures file %s", fixtureFile), err)
+	}
+	fixtures := mockFixtures{}
+	decoder := json.NewDecoder(bytes.NewReader(contents))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&fixtures); err != nil {
+		return errorToDiags(fmt.Sprintf("parse mock fixtures file %s", fixtureFile), err)
+	}
+
+	if len(fixtures.Datacenters) != 0 || len(fixtures.Clusters) != 0 || len(fixtures.Hosts) != 0 ||
+		len(fixtures.StorageDomains) != 0 || len(fixtures.Networks) != 0 {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Mock fixtures contain objects the mock engine cannot create",
+				Detail: fmt.Sprintf(
+					"The mock engine cannot create data centers, clusters, hosts, storage domains or networks, please remove them from %s. The built-in ones are always available.",
+					fixtureFile,
+				),
+			},
+		}
+	}
+	diags := diag.Diagnostics{}
+	diags = append(diags, loadMockFixtureTags(client, fixtures.Tags)...)
+	diags = append(diags, loadMockFixtureVNICProfiles(client, fixtures.VNICProfiles)...)
+	diags = append(diags, loadMockFixtureTemplates(client, fixtures.Templates)...)
+	return diags
+}
+
+func loadMockFixtureTags(client ovirtclient.Client, tags []mockFixtureTag) diag.Diagnostics {
+	if len(tags) == 0 {
+		return nil
+	}
+	existingTags, err := client.ListTags()
+	if err != nil {
+		return errorToDiags("list tags", err)
+	}
+	existing := map[string]bool{}
+	for _, tag := range existingTags {
+		existing[tag.Name()] = true
+	}
+
+	diags := diag.Diagnostics{}
+	for _, tag := range tags {
+		if existing[tag.Name] {
+			continue
+		}
+		params, err := ovirtclient.NewCreateTagParams().WithDescription(tag.Description)
+		if err != nil {
+			diags = appendDiags(diags, fmt.Sprintf("set description on tag %s", tag.Name), err)
+			continue
+		}
+		if _, err := client.CreateTag(tag.Name, params); err != nil {
+			diags = appendDiags(diags, fmt.Sprintf("create tag %s", tag.Name), err)
+		}
+	}
+	return diags
+}
+
+func loadMockFixtureVNICProfiles(client ovirtclient.Client, vnicProfiles []mockFixtureVNICProfile) diag.Diagnostics {
+	if len(vnicProfiles) == 0 {
+		return nil
+	}
+	networks, err := client.ListNetworks()
+	if err != nil {
+		return errorToDiags("list networks", err)
+	}
+	networkIDs := map[string]ovirtclient.NetworkID{}
+	for _, network := range networks {
+		networkIDs[network.Name()] = network.ID()
+	}
+	existingVNICProfiles, err := client.ListVNICProfiles()
+	if err != nil {
+		return errorToDiags("list VNIC profiles", err)
+	}
+	existing := map[string]bool{}
+	for _, vnicProfile := range existingVNICProfiles {
+		existing[vnicProfile.Name()] = true
+	}
+
+	diags := diag.Diagnostics{}
+	for _, vnicProfile := range vnicProfiles {
+		if existing[vnicProfile.Name] {
+			continue
+		}
+		networkID, ok := networkIDs[vnicProfile.Network]
+		if !ok {
+			diags = appendDiags(
+				diags,
+				fmt.Sprintf("create VNIC profile %s", vnicProfile.Name),
+				fmt.Errorf("the mock engine has no network named %q", vnicProfile.Network),
+			)
+			continue
+		}
+		if _, err := client.CreateVNICProfile(
+			vnicProfile.Name,
+			networkID,
+			ovirtclient.CreateVNICProfileParams(),
+		); err != nil {
+			diags = appendDiags(diags, fmt.Sprintf("create VNIC profile %s", vnicProfile.Name), err)
+		}
+	}
+	return diags
+}
+
+// loadMockFixtureTemplates creates each template from a temporary VM with the requested disks. The VMs are removed
+// once the templates are ready.
+func loadMockFixtureTemplates(client ovirtclient.Client, templates []mockFixtureTemplate) diag.Diagnostics {
+	if len(templates) == 0 {
+		return nil
+	}
+	existingTemplates, err := client.ListTemplates()
+	if err != nil {
+		return errorToDiags("list templates", err)
+	}
+	existing := map[string]bool{}
+	for _, tpl := range existingTemplates {
+		existing[tpl.Name()] = true
+	}
+	clusters, err := client.ListClusters()
+	if err != nil {
+		return errorToDiags("list clusters", err)
+	}
+	storageDomains, err := client.ListStorageDomains()
+	if err != nil {
+		return errorToDiags("list storage domains", err)
+	}
+	if len(clusters) == 0 || len(storageDomains) == 0 {
+		return errorToDiags("create templates", fmt.Errorf("the mock engine has no cluster or storage domain"))
+	}
+
+	diags := diag.Diagnostics{}
+	createdTemplates := map[ovirtclient.TemplateID]ovirtclient.VMID{}
+	for _, tpl := range templates {
+		if existing[tpl.Name] {
+			continue
+		}
+		vm, err := client.CreateVM(
+			clusters[0].ID(),
+			ovirtclient.DefaultBlankTemplateID,
+			fmt.Sprintf("fixture-%s", tpl.Name),
+			nil,
+		)
+		if err != nil {
+			diags = appendDiags(diags, fmt.Sprintf("create VM for template %s", tpl.Name), err)
+			continue
+		}
+		templateID, err := createMockFixtureTemplate(client, vm.ID(), storageDomains[0].ID(), tpl)
+		if err != nil {
+			diags = appendDiags(diags, fmt.Sprintf("create template %s", tpl.Name), err)
+			if err := client.RemoveVM(vm.ID()); err != nil {
+				diags = appendDiags(diags, fmt.Sprintf("remove VM %s used to create template %s", vm.ID(), tpl.Name), err)
+			}
+			continue
+		}
+		createdTemplates[templateID] = vm.ID()
+	}
+	for templateID, vmID := range createdTemplates {
+		if _, err := client.WaitForTemplateStatus(templateID, ovirtclient.TemplateStatusOK); err != nil {
+			diags = appendDiags(diags, fmt.Sprintf("wait for template %s", templateID), err)
+			continue
+		}
+		if err := client.RemoveVM(vmID); err != nil {
+			diags = appendDiags(diags, fmt.Sprintf("remove VM %s used to create template %s", vmID, templateID), err)
+		}
+	}
+	return diags
+}
+
+// createMockFixtureTemplate adds the disks of the template to the VM and creates the template from it. The template is
+// only created if all of its disks could be created, so no template with missing disks is left behind.
+func createMockFixtureTemplate(
+	client ovirtclient.Client,
+	vmID ovirtclient.VMID,
+	storageDomainID ovirtclient.StorageDomainID,
+	tpl mockFixtureTemplate,
+) (ovirtclient.TemplateID, error) {
+	for i, disk := range tpl.Disks {
+		if err := createMockFixtureDisk(client, vmID, storageDomainID, disk); err != nil {
+			return "", fmt.Errorf("failed to create disk %d (%w)", i, err)
+		}
+	}
+	params, err := ovirtclient.TemplateCreateParams().WithDescription(tpl.Description)
+	if err != nil {
+		return "", fmt.Errorf("failed to set description (%w)", err)
+	}
+	template, err := client.CreateTemplate(vmID, tpl.Name, params)
+	if err != nil {
+		return "", err
+	}
+	return template.ID(), nil
+}
+
+func createMockFixtureDisk(
+	client ovirtclient.Client,
+	vmID ovirtclient.VMID,
+	storageDomainID ovirtclient.StorageDomainID,
+	disk mockFixtureTemplateDisk,
+) error {
+	createdDisk, err := client.CreateDisk(
+		storageDomainID,
+		ovirtclient.ImageFormat(disk.Format),
+		disk.Size,
+		ovirtclient.CreateDiskParams(),
+	)
+	if err != nil {
+		return err
+	}
+	params, err := ovirtclient.CreateDiskAttachmentParams().WithBootable(disk.Bootable)
+	if err != nil {
+		return err
+	}
+	_, err = client.CreateDiskAttachment(vmID, createdDisk.ID(), ovirtclient.DiskInterface(disk.Interface), params)
+	return err
+}
+
+// ExportMockFixtures writes the inventory of the engine the client is connected to in the format accepted by the
+// mock_fixtures provider option. VMs and disks not belonging to templates are not exported, neither are data centers,
+// clusters, hosts, storage domains and networks, which the mock engine cannot create.
+func ExportMockFixtures(client ovirtclient.Client, output io.Writer) error {
+	fixtures := mockFixtures{}
+
+	networks, err := client.ListNetworks()
+	if err != nil {
+		return fmt.Errorf("failed to list networks (%w)", err)
+	}
+	networkNames := map[ovirtclient.NetworkID]string{}
+	for _, network := range networks {
+		networkNames[network.ID()] = network.Name()
+	}
+
+	vnicProfiles, err := client.ListVNICProfiles()
+	if err != nil {
+		return fmt.Errorf("failed to list VNIC profiles (%w)", err)
+	}
+	for _, vnicProfile := range vnicProfiles {
+		fixtures.VNICProfiles = append(
+			fixtures.VNICProfiles, mockFixtureVNICProfile{
+				Name:    vnicProfile.Name(),
+				Network: networkNames[vnicProfile.NetworkID()],
+			},
+		)
+	}
+
+	templates, err := client.ListTemplates()
+	if err != nil {
+		return fmt.Errorf("failed to list templates (%w)", err)
+	}
+	for _, tpl := range templates {
+		if tpl.ID() == ovirtclient.DefaultBlankTemplateID {
+			continue
+		}
+		fixtureTemplate, err := exportMockFixtureTemplate(client, tpl)
+		if err != nil {
+			return err
+		}
+		fixtures.Templates = append(fixtures.Templates, fixtureTemplate)
+	}
+
+	tags, err := client.ListTags()
+	if err != nil {
+		return fmt.Errorf("failed to list tags (%w)", err)
+	}
+	for _, tag := range tags {
+		fixtureTag := mockFixtureTag{
+			Name: tag.Name(),
+		}
+		if description := tag.Description(); description != nil {
+			fixtureTag.Description = *description
+		}
+		fixtures.Tags = append(fixtures.Tags, fixtureTag)
+	}
+
+	encoder := json.NewEncoder(output)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(fixtures); err != nil {
+		return fmt.Errorf("failed to write mock fixtures (%w)", err)
+	}
+	return nil
+}
+
+func exportMockFixtureTemplate(client ovirtclient.Client, tpl ovirtclient.Template) (mockFixtureTemplate, error) {
+	fixtureTemplate := mockFixtureTemplate{
+		Name:        tpl.Name(),
+		Description: tpl.Description(),
+	}
+	attachments, err := client.ListTemplateDiskAttachments(tpl.ID())
+	if err != nil {
+		return fixtureTemplate, fmt.Errorf("failed to list disks of template %s (%w)", tpl.Name(), err)
+	}
+	for _, attachment := range attachments {
+		disk, err := client.GetDisk(attachment.DiskID())
+		if err != nil {
+			return fixtureTemplate, fmt.Errorf("failed to get disk %s of template %s (%w)", attachment.DiskID(), tpl.Name(), err)
+		}
+		fixtureTemplate.Disks = append(
+			fixtureTemplate.Disks, mockFixtureTemplateDisk{
+				Size:      disk.ProvisionedSize(),
+				Format:    string(disk.Format()),
+				Interface: string(attachment.DiskInterface()),
+				Bootable:  attachment.Bootable(),
+			},
+		)
+	}
+	return fixtureTemplate, nil
+}
@@ -0,0 +1,171 @@
+package ovirt
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const testMockFixtures = `{
+  "vnic_profiles": [
+    {"name": "fixture-profile", "network": "test"}
+  ],
+  "templates": [
+    {
+      "name": "fixture-template",
+      "description": "Template loaded from fixtures",
+      "disks": [
+        {"size": 1048576, "format": "raw", "interface": "virtio_scsi", "bootable": true}
+      ]
+    }
+  ],
+  "tags": [
+    {"name": "fixture-tag", "description": "Tag loaded from fixtures"}
+  ]
+}`
+
+func writeTestMockFixtures(t *testing.T, fixtures string) string {
+	fixtureFile := filepath.Join(t.TempDir(), "fixtures.json")
+	if err := os.WriteFile(fixtureFile, []byte(fixtures), 0o600); err != nil {
+		t.Fatalf("failed to write mock fixtures (%v)", err)
+	}
+	return fixtureFile
+}
+
+func TestMockFixturesTemplatesDataSource(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(newTestLogger(t))
+	config := fmt.Sprintf(
+		`
+provider "ovirt" {
+	mock          = true
+	mock_fixtures = "%s"
+}
+
+data "ovirt_templates" "fixture" {
+	name          = "fixture-template"
+	fail_on_empty = true
+}
+`,
+		writeTestMockFixtures(t, testMockFixtures),
+	)
+
+	resource.UnitTest(
+		t, resource.TestCase{
+			ProviderFactories: p.getProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.ovirt_templates.fixture", "templates.#", "1"),
+					),
+				},
+			},
+		},
+	)
+}
+
+func TestMockFixturesLoadAndExport(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(newTestLogger(t))
+	client := p.getTestHelper().GetClient().WithContext(context.Background())
+	fixtureFile := writeTestMockFixtures(t, testMockFixtures)
+
+	diags := loadMockFixtures(client, fixtureFile)
+	if diags.HasError() {
+		t.Fatalf("failed to load mock fixtures (%v)", diags)
+	}
+	if len(diags) != 0 {
+		t.Fatalf("unexpected diagnostics while loading mock fixtures (%v)", diags)
+	}
+	if diags := loadMockFixtures(client, fixtureFile); diags.HasError() {
+		t.Fatalf("failed to load mock fixtures a second time (%v)", diags)
+	}
+
+	tpl, err := client.GetTemplateByName("fixture-template")
+	if err != nil {
+		t.Fatalf("template from fixtures not found (%v)", err)
+	}
+	attachments, err := client.ListTemplateDiskAttachments(tpl.ID())
+	if err != nil {
+		t.Fatalf("failed to list template disk attachments (%v)", err)
+	}
+	if len(attachments) != 1 {
+		t.Fatalf("expected 1 disk on the template from fixtures, got %d", len(attachments))
+	}
+
+	output := &bytes.Buffer{}
+	if err := ExportMockFixtures(client, output); err != nil {
+		t.Fatalf("failed to export mock fixtures (%v)", err)
+	}
+	exported := mockFixtures{}
+	if err := json.Unmarshal(output.Bytes(), &exported); err != nil {
+		t.Fatalf("failed to parse exported mock fixtures (%v)", err)
+	}
+	if len(exported.Templates) != 1 || len(exported.Templates[0].Disks) != 1 {
+		t.Fatalf("unexpected templates in exported mock fixtures: %v", exported.Templates)
+	}
+	if len(exported.Tags) != 1 || exported.Tags[0].Name != "fixture-tag" {
+		t.Fatalf("unexpected tags in exported mock fixtures: %v", exported.Tags)
+	}
+}
+
+func TestMockFixturesRejected(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		fixtures string
+		template string
+	}{
+		"cluster": {
+			fixtures: `{"clusters": [{"name": "Production", "datacenter": "Default"}]}`,
+		},
+		"vnic-profile-on-unknown-network": {
+			fixtures: `{"vnic_profiles": [{"name": "fixture-profile", "network": "nonexistent"}]}`,
+		},
+		"template-with-invalid-disk": {
+			fixtures: `{
+  "templates": [
+    {
+      "name": "fixture-invalid-template",
+      "disks": [
+        {"size": 1048576, "format": "raw", "interface": "virtio_scsi", "bootable": true},
+        {"size": 1048576, "format": "invalid", "interface": "virtio_scsi"}
+      ]
+    }
+  ]
+}`,
+			template: "fixture-invalid-template",
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(
+			name, func(t *testing.T) {
+				t.Parallel()
+
+				p := newProvider(newTestLogger(t))
+				client := p.getTestHelper().GetClient().WithContext(context.Background())
+
+				diags := loadMockFixtures(client, writeTestMockFixtures(t, testCase.fixtures))
+				if !diags.HasError() {
+					t.Fatalf("invalid mock fixtures were loaded without an error")
+				}
+				if testCase.template == "" {
+					return
+				}
+				if _, err := client.GetTemplateByName(testCase.template); err == nil {
+					t.Fatalf("template %s was created with missing disks", testCase.template)
+				}
+			},
+		)
+	}
+}
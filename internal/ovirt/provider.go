@@ -3,7 +3,9 @@ package ovirt
 import (
 	"context"
 	"fmt"
+	"math"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
@@ -18,18 +20,18 @@ var providerSchema = map[string]*schema.Schema{
 	"username": {
 		Type:        schema.TypeString,
 		Optional:    true,
-		Description: "Username and realm for oVirt authentication. Required when mock = false. Example: `admin@internal`",
+		Description: "Username and realm for oVirt authentication. Required when mock = false. Can also be set using the `OVIRT_USERNAME` environment variable or the `username` key of the config file profile. Example: `admin@internal`",
 	},
 	"password": {
 		Type:        schema.TypeString,
 		Optional:    true,
 		Sensitive:   true,
-		Description: "Password for oVirt authentication. Required when mock = false.",
+		Description: "Password for oVirt authentication. Required when mock = false. Can also be set using the `OVIRT_PASSWORD` environment variable or the `password` key of the config file profile.",
 	},
 	"url": {
 		Type:        schema.TypeString,
 		Optional:    true,
-		Description: "URL for the oVirt engine API. Required when mock = false. Example: `https://example.com/ovirt-engine/api/`",
+		Description: "URL for the oVirt engine API. Required when mock = false. Can also be set using the `OVIRT_URL` environment variable or the `url` key of the config file profile. Example: `https://example.com/ovirt-engine/api/`",
 	},
 	"extra_headers": {
 		Type:        schema.TypeMap,
@@ -41,7 +43,7 @@ var providerSchema = map[string]*schema.Schema{
 		Type:             schema.TypeBool,
 		Optional:         true,
 		ValidateDiagFunc: validateTLSInsecure,
-		Description:      "Disable certificate verification when connecting the Engine. This is not recommended. Setting this option is incompatible with other `tls_` options.",
+		Description:      "Disable certificate verification when connecting the Engine. This is not recommended. Setting this option is incompatible with other `tls_` options. Can also be set using the `OVIRT_INSECURE` environment variable or the `insecure` key of the config file profile.",
 	},
 	"tls_system": {
 		Type:             schema.TypeBool,
@@ -58,7 +60,7 @@ var providerSchema = map[string]*schema.Schema{
 		Type:        schema.TypeList,
 		Elem:        &schema.Schema{Type: schema.TypeString},
 		Optional:    true,
-		Description: "Validate the Engine certificate against the CA certificates provided in the files in this parameter. The files should contain certificates in PEM format. Can be used in parallel with other tls_ options, one tls_ option is required when mock = false.",
+		Description: "Validate the Engine certificate against the CA certificates provided in the files in this parameter. The files should contain certificates in PEM format. Can be used in parallel with other tls_ options, one tls_ option is required when mock = false. Can also be set using the `OVIRT_CAFILE` environment variable or the `ca_file` key of the config file profile, multiple files are separated by the OS path list separator.",
 		// Validating TypeList fields is not yet supported in Terraform.
 		//ValidateDiagFunc: validateFilesExist,
 	},
@@ -70,12 +72,108 @@ var providerSchema = map[string]*schema.Schema{
 		// Validating TypeList fields is not yet supported in Terraform.
 		//ValidateDiagFunc: validateDirsExist,
 	},
+	"config_file": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		DefaultFunc: schema.EnvDefaultFunc("OVIRT_CONFIG_FILE", nil),
+		Description: "Path to an INI or YAML (`.yaml` or `.yml` extension) file with connection profiles. The `url`, `username`, `password`, `ca_file` and `insecure` keys of the selected profile are used for options not set in the provider configuration or the environment. Keys may be prefixed with `ovirt_`. Can also be set using the `OVIRT_CONFIG_FILE` environment variable.",
+	},
+	"profile": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		DefaultFunc: schema.EnvDefaultFunc("OVIRT_PROFILE", "default"),
+		Description: "Name of the profile (INI section or top-level YAML key) to read from `config_file`. Can also be set using the `OVIRT_PROFILE` environment variable. Defaults to `default`.",
+	},
+	"retry": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Retry policy for API calls that fail with a transient error, for example while the engine is restarting or while a disk is locked.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_attempts": {
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Default:          10,
+					ValidateDiagFunc: validateIntBetween(1, math.MaxUint16),
+					Description:      "Maximum number of times a failing API call is retried. This also limits how many times the provider polls the engine while waiting for a resource to reach a certain state.",
+				},
+				"backoff_factor": {
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Default:          2,
+					ValidateDiagFunc: validateIntBetween(1, math.MaxUint8),
+					Description:      "Factor by which the wait time between retries grows. The first retry happens after one second.",
+				},
+				"retryable_error_codes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Errors to retry in addition to the ones the oVirt client library already considers transient (such as `disk_locked` or `conflict`). Each entry is either an HTTP status code returned by the engine, for example `503` or `409`, or an oVirt client error code.",
+				},
+			},
+		},
+	},
+	"max_concurrent_requests": {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Default:          0,
+		ValidateDiagFunc: validateIntBetween(0, math.MaxInt32),
+		Description:      "Maximum number of API calls running at the same time across all resources. A call only occupies a slot while it talks to the engine, not while it waits between retries or for a resource to reach a certain state. 0 means unlimited.",
+	},
 	"mock": {
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
 		Description: "When set to true, the Terraform provider runs against an internal simulation. This should only be used for testing when an oVirt engine is not available as the mock backend does not persist state across runs. When set to false, one of the tls_ options is required.",
 	},
+	"mock_fixtures": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: validateLocalFile,
+		Description:      "Path to a JSON file describing templates (including their disks), tags and VNIC profiles to preload into the mock engine when mock = true. Objects already present by name are skipped. Data centers, clusters, hosts, storage domains and networks cannot be created in the mock engine, files listing them are rejected. VNIC profiles must reference the built-in network `test`, and a template is only created if all of its disks can be created. Such a file can be exported from a live engine with `terraform-provider-ovirt export-mock-fixtures`.",
+	},
+	"mock_faults": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Faults to inject into calls to the mock engine when mock = true, for testing how modules deal with failures and slow operations. Injected errors are retried like errors from a live engine, according to the `retry` block and the error code.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"method": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: validateEnum(clientMethodNames()),
+					Description:      "Name of the oVirt client method to inject the fault into, for example `CreateVM` or `WaitForVMStatus`.",
+				},
+				"error_code": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validateNonEmpty,
+					Description:      "oVirt client error code to fail the call with, for example `not_found` or `conflict`. If not set, the call does not fail.",
+				},
+				"on_call": {
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Default:          0,
+					ValidateDiagFunc: validateIntBetween(0, math.MaxInt32),
+					Description:      "Only fail the Nth call of the method, counting from 1. Every retry counts as a separate call. 0 means every call.",
+				},
+				"probability": {
+					Type:             schema.TypeFloat,
+					Optional:         true,
+					Default:          1.0,
+					ValidateDiagFunc: validateFloatBetween(0, 1),
+					Description:      "Probability between 0 and 1 that a matching call fails.",
+				},
+				"latency": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validateDuration,
+					Description:      "Delay to add before each call of the method, including retries, for example `30s`.",
+				},
+			},
+		},
+	},
 }
 
 // New returns a new Terraform provider schema for oVirt.
@@ -83,6 +181,17 @@ func New() func() *schema.Provider {
 	return newProvider(newTerraformLogger()).getProvider
 }
 
+// NewMock returns a Terraform provider schema for oVirt that always runs against the mock engine of the specified
+// test helper, regardless of the mock option. This allows tests to inspect and prepare the state of the engine through
+// the client of the test helper.
+func NewMock(helper ovirtclient.TestHelper) func() *schema.Provider {
+	p := &provider{
+		testHelper: helper,
+		forceMock:  true,
+	}
+	return p.getProvider
+}
+
 func newProvider(logger ovirtclientlog.Logger) providerInterface {
 	helper, err := ovirtclient.NewMockTestHelper(
 		logger,
@@ -104,6 +213,8 @@ type providerInterface interface {
 type provider struct {
 	testHelper ovirtclient.TestHelper
 	client     ovirtclient.Client
+	engine     *engineInfo
+	forceMock  bool
 }
 
 func (p *provider) getTestHelper() ovirtclient.TestHelper {
@@ -131,15 +242,27 @@ func (p *provider) getProvider() *schema.Provider {
 			"ovirt_nic":                      p.nicResource(),
 			"ovirt_tag":                      p.tagResource(),
 			"ovirt_template":                 p.templateResource(),
+			"ovirt_vnic_profile":             p.vnicProfileResource(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"ovirt_blank_template":            p.blankTemplateDataSource(),
+			"ovirt_engine_info":               p.engineInfoDataSource(),
 			"ovirt_disk_attachments":          p.diskAttachmentsDataSource(),
 			"ovirt_template_disk_attachments": p.templateDiskAttachmentsDataSource(),
 			"ovirt_cluster_hosts":             p.clusterHostsDataSource(),
 			"ovirt_templates":                 p.templatesDataSource(),
 			"ovirt_affinity_group":            p.affinityGroupDataSource(),
 			"ovirt_wait_for_ip":               p.waitForIPDataSource(),
+			"ovirt_networks":                  p.networksDataSource(),
+			"ovirt_vnic_profiles":             p.vnicProfilesDataSource(),
+			"ovirt_cluster":                   p.clusterDataSource(),
+			"ovirt_clusters":                  p.clustersDataSource(),
+			"ovirt_datacenter":                p.datacenterDataSource(),
+			"ovirt_datacenters":               p.datacentersDataSource(),
+			"ovirt_host":                      p.hostDataSource(),
+			"ovirt_hosts":                     p.hostsDataSource(),
+			"ovirt_storage_domain":            p.storageDomainDataSource(),
+			"ovirt_storage_domains":           p.storageDomainsDataSource(),
 		},
 	}
 }
@@ -155,24 +278,109 @@ func (p *provider) getProviderFactories() map[string]func() (*schema.Provider, e
 func (p *provider) configureProvider(ctx context.Context, data *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	diags := diag.Diagnostics{}
 
-	if mock, ok := data.GetOk("mock"); ok && mock == true {
-		p.client = p.testHelper.GetClient()
+	if mock, ok := data.GetOk("mock"); p.forceMock || (ok && mock == true) {
+		handlers := clientCallHandlers(data)
+		if rules := mockFaultRules(data); len(rules) != 0 {
+			handlers = append(handlers, mockFaultsHandler(rules))
+		}
+		client := wrapClient(p.testHelper.GetClient(), handlers...)
+		if fixtureFile, ok := data.GetOk("mock_fixtures"); ok {
+			// Fixtures are loaded without the injected faults.
+			diags = append(diags, loadMockFixtures(p.testHelper.GetClient().WithContext(ctx), fixtureFile.(string))...)
+			if diags.HasError() {
+				return nil, diags
+			}
+		}
+		p.client = client
+		engine, engineDiags := detectEngineInfo(client.WithContext(ctx))
+		p.engine = engine
+		diags = append(diags, engineDiags...)
 		return p, diags
 	}
+	for _, mockOption := range []string{"mock_fixtures", "mock_faults"} {
+		if _, ok := data.GetOk(mockOption); ok {
+			diags = append(
+				diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("The %s option requires mock = true", mockOption),
+					Detail:   fmt.Sprintf("The %s option only applies to the mock engine. Remove it or set mock = true.", mockOption),
+				},
+			)
+		}
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
 
-	url, diags := extractString(data, "url", diags)
-	username, diags := extractString(data, "username", diags)
-	password, diags := extractString(data, "password", diags)
+	client, clientDiags := newClient(ctx, data, &terraformLogger{ctx: ctx})
+	diags = append(diags, clientDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	p.client = wrapClient(client, clientCallHandlers(data)...)
+	engine, engineDiags := detectEngineInfo(p.client.WithContext(ctx))
+	p.engine = engine
+	diags = append(diags, engineDiags...)
+	return p, diags
+}
+
+// NewClientFromOptions connects to the oVirt engine outside of Terraform, for example in subcommands. The options are
+// provider options by name, such as url or tls_insecure. Options that are not set are looked up in the environment
+// variables and the config file profile in the same way as for the provider configuration. If no CA certificates and
+// no insecure mode are configured in any of these places, the system certificates are trusted.
+func NewClientFromOptions(options map[string]interface{}, logger ovirtclientlog.Logger) (ovirtclient.Client, error) {
+	data := (&schema.Resource{Schema: providerSchema}).Data(nil)
+	for option, value := range options {
+		if err := data.Set(option, value); err != nil {
+			return nil, fmt.Errorf("invalid value for the %s option (%w)", option, err)
+		}
+	}
+	// Errors in the settings are reported by newClient below.
+	if settings, _ := resolveProviderSettings(data); settings != nil && !settings.hasTLSTrust(data) {
+		if err := data.Set("tls_system", true); err != nil {
+			return nil, fmt.Errorf("failed to set the tls_system option (%w)", err)
+		}
+	}
+	client, diags := newClient(context.Background(), data, logger)
+	for _, d := range diags {
+		if d.Severity == diag.Warning {
+			logger.Warningf("%s: %s", d.Summary, d.Detail)
+		}
+	}
+	if err := diagsToError(diags); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// newClient creates a client for a live oVirt engine from the connection options of the provider.
+func newClient(
+	ctx context.Context,
+	data *schema.ResourceData,
+	logger ovirtclientlog.Logger,
+) (ovirtclient.Client, diag.Diagnostics) {
+	settings, diags := resolveProviderSettings(data)
+	if settings == nil {
+		return nil, diags
+	}
+	tflog.Info(ctx, "Resolved oVirt connection settings", map[string]interface{}{"sources": settings.describeSources()})
+
+	url, diags := settings.getString("url", diags)
+	username, diags := settings.getString("username", diags)
+	password, diags := settings.getString("password", diags)
 
 	tls := ovirtclient.TLS()
-	if insecure, ok := data.GetOk("tls_insecure"); ok && insecure == true {
+	if settings.getBool("tls_insecure") {
 		tls.Insecure()
+		if settings.sources["tls_insecure"] != providerConfigurationSource {
+			diags = append(diags, validateTLSInsecure(true, nil)...)
+		}
 	}
 	if system, ok := data.GetOk("tls_system"); ok && system == true {
 		tls.CACertsFromSystem()
 	}
 
-	caFiles, diags := getStringSliceFromResource("tls_ca_files", data, diags)
+	caFiles, diags := settings.getStringSlice("tls_ca_files", diags)
 	for _, caFile := range caFiles {
 		tls.CACertsFromFile(caFile)
 	}
@@ -197,7 +405,7 @@ func (p *provider) configureProvider(ctx context.Context, data *schema.ResourceD
 		}
 	}
 
-	if len(diags) != 0 {
+	if diags.HasError() {
 		return nil, diags
 	}
 
@@ -206,9 +414,7 @@ func (p *provider) configureProvider(ctx context.Context, data *schema.ResourceD
 		username,
 		password,
 		tls,
-		&terraformLogger{
-			ctx: ctx,
-		},
+		logger,
 		nil,
 	)
 	if err != nil {
@@ -216,14 +422,40 @@ func (p *provider) configureProvider(ctx context.Context, data *schema.ResourceD
 			diags, diag.Diagnostic{
 				Severity:      diag.Error,
 				Summary:       "Failed to create oVirt client",
-				Detail:        err.Error(),
+				Detail:        fmt.Sprintf("%v\n\nConnection settings were taken from:\n%s", err, settings.describeSources()),
 				AttributePath: nil,
 			},
 		)
 		return nil, diags
 	}
-	p.client = client
-	return p, diags
+	return client, diags
+}
+
+// clientCallHandlers returns the handlers implementing the retry and max_concurrent_requests provider options.
+func clientCallHandlers(data *schema.ResourceData) []clientCallHandler {
+	var handlers []clientCallHandler
+	if retry, ok := data.GetOk("retry"); ok {
+		retryConfig := retry.([]interface{})[0].(map[string]interface{})
+		var retryableErrorCodes []string
+		for _, code := range retryConfig["retryable_error_codes"].(*schema.Set).List() {
+			retryableErrorCodes = append(retryableErrorCodes, code.(string))
+		}
+		handlers = append(
+			handlers, retryPolicyHandler(
+				//nolint:gosec // G115: the values are checked by validateIntBetween
+				retryPolicy{
+					maxAttempts:         uint16(retryConfig["max_attempts"].(int)),
+					backoffFactor:       uint8(retryConfig["backoff_factor"].(int)),
+					retryableErrorCodes: retryableErrorCodes,
+				},
+			),
+		)
+	}
+	// The concurrency limit comes after the retry policy so that it sees the retry strategies of the call.
+	if limit := data.Get("max_concurrent_requests").(int); limit > 0 {
+		handlers = append(handlers, concurrencyLimitHandler(limit))
+	}
+	return handlers
 }
 
 func getStringSliceFromResource(fieldName string, data *schema.ResourceData, diags diag.Diagnostics) ([]string, diag.Diagnostics) {
@@ -0,0 +1,272 @@
+package ovirt
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/ini.v1"
+	"gopkg.in/yaml.v3"
+)
+
+// providerConfigurationSource is the source of options set directly in the provider configuration.
+const providerConfigurationSource = "provider configuration"
+
+// providerFallback describes where a provider option is looked up if it is not set in the provider configuration.
+type providerFallback struct {
+	option  string
+	envVar  string
+	fileKey string
+}
+
+// providerFallbacks lists the options that can be set from the environment or from a config file profile. The
+// provider configuration takes precedence over the environment variable, which takes precedence over the config file.
+var providerFallbacks = []providerFallback{
+	{option: "url", envVar: "OVIRT_URL", fileKey: "url"},
+	{option: "username", envVar: "OVIRT_USERNAME", fileKey: "username"},
+	{option: "password", envVar: "OVIRT_PASSWORD", fileKey: "password"},
+	{option: "tls_ca_files", envVar: "OVIRT_CAFILE", fileKey: "ca_file"},
+	{option: "tls_insecure", envVar: "OVIRT_INSECURE", fileKey: "insecure"},
+}
+
+// providerSettings contains the resolved values of the options in providerFallbacks and where each of them came from.
+type providerSettings struct {
+	values  map[string]interface{}
+	sources map[string]string
+}
+
+// resolveProviderSettings resolves the options in providerFallbacks from the provider configuration, the environment
+// variables and the config file profile, in this order.
+func resolveProviderSettings(data *schema.ResourceData) (*providerSettings, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	settings := &providerSettings{
+		values:  map[string]interface{}{},
+		sources: map[string]string{},
+	}
+
+	var profileValues map[string]string
+	configFile := data.Get("config_file").(string)
+	profile := data.Get("profile").(string)
+	if configFile != "" {
+		var err error
+		profileValues, err = readProviderConfigFile(configFile, profile)
+		if err != nil {
+			return nil, append(
+				diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to read the oVirt config file",
+					Detail:   err.Error(),
+				},
+			)
+		}
+		diags = append(diags, unknownConfigFileKeys(configFile, profile, profileValues)...)
+	}
+
+	for _, fallback := range providerFallbacks {
+		if isSetInConfig(data, fallback.option) {
+			settings.values[fallback.option] = data.Get(fallback.option)
+			settings.sources[fallback.option] = providerConfigurationSource
+			continue
+		}
+		var value, source string
+		if envValue := os.Getenv(fallback.envVar); envValue != "" {
+			value = envValue
+			source = fmt.Sprintf("environment variable %s", fallback.envVar)
+		} else if fileValue, ok := profileValues[fallback.fileKey]; ok && fileValue != "" {
+			value = fileValue
+			source = fmt.Sprintf("key %s in profile %s of %s", fallback.fileKey, profile, configFile)
+		} else {
+			continue
+		}
+		switch providerSchema[fallback.option].Type {
+		case schema.TypeBool:
+			boolValue, err := strconv.ParseBool(value)
+			if err != nil {
+				diags = append(
+					diags, diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  fmt.Sprintf("Invalid value for the %s option", fallback.option),
+						Detail:   fmt.Sprintf("The value %q from %s is not a boolean.", value, source),
+					},
+				)
+				continue
+			}
+			settings.values[fallback.option] = boolValue
+		case schema.TypeList:
+			settings.values[fallback.option] = filepath.SplitList(value)
+		default:
+			settings.values[fallback.option] = value
+		}
+		settings.sources[fallback.option] = source
+	}
+	return settings, diags
+}
+
+// getString returns a string option, or an error diagnostic explaining where the option can be set if it is missing.
+func (s *providerSettings) getString(option string, diags diag.Diagnostics) (string, diag.Diagnostics) {
+	value, _ := s.values[option].(string)
+	if value == "" {
+		return "", append(
+			diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("The %s option is not set", option),
+				Detail: fmt.Sprintf(
+					"The %s option must be set in the provider configuration, the %s environment variable or the config file profile if mock=false",
+					option,
+					providerFallbackFor(option).envVar,
+				),
+			},
+		)
+	}
+	return value, diags
+}
+
+// hasTLSTrust checks if any of the options deciding which engine certificates to trust is set.
+func (s *providerSettings) hasTLSTrust(data *schema.ResourceData) bool {
+	if _, ok := s.values["tls_ca_files"]; ok || s.getBool("tls_insecure") {
+		return true
+	}
+	for _, option := range []string{"tls_system", "tls_ca_dirs", "tls_ca_bundle"} {
+		if _, ok := data.GetOk(option); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *providerSettings) getBool(option string) bool {
+	value, _ := s.values[option].(bool)
+	return value
+}
+
+func (s *providerSettings) getStringSlice(option string, diags diag.Diagnostics) ([]string, diag.Diagnostics) {
+	switch value := s.values[option].(type) {
+	case []string:
+		return value, diags
+	case []interface{}:
+		result := make([]string, 0, len(value))
+		for _, item := range value {
+			itemString, ok := item.(string)
+			if !ok {
+				return nil, append(
+					diags, diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  fmt.Sprintf("The %s option must be a list of strings", option),
+						Detail:   fmt.Sprintf("The %s option must be a list of strings. Value %v is not a string", option, item),
+					},
+				)
+			}
+			result = append(result, itemString)
+		}
+		return result, diags
+	}
+	return nil, diags
+}
+
+// describeSources returns a human-readable list of where each resolved option came from. Values are not included.
+func (s *providerSettings) describeSources() string {
+	lines := make([]string, 0, len(s.sources))
+	for _, fallback := range providerFallbacks {
+		if source, ok := s.sources[fallback.option]; ok {
+			lines = append(lines, fmt.Sprintf("%s from %s", fallback.option, source))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func providerFallbackFor(option string) providerFallback {
+	for _, fallback := range providerFallbacks {
+		if fallback.option == option {
+			return fallback
+		}
+	}
+	panic(fmt.Errorf("bug: no fallback for provider option %s", option))
+}
+
+// isSetInConfig returns true if the option is present in the provider configuration, even if it is set to its zero
+// value.
+func isSetInConfig(data *schema.ResourceData, option string) bool {
+	rawConfig := data.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.Type().IsObjectType() {
+		_, ok := data.GetOk(option)
+		return ok
+	}
+	return !rawConfig.GetAttr(option).IsNull()
+}
+
+// readProviderConfigFile reads a profile from an INI or, if the file has a .yaml or .yml extension, YAML config file.
+// Keys may be prefixed with ovirt_ to stay compatible with the ovirt.ini files used by other oVirt tools.
+func readProviderConfigFile(configFile string, profile string) (map[string]string, error) {
+	var values map[string]string
+	switch strings.ToLower(filepath.Ext(configFile)) {
+	case ".yaml", ".yml":
+		contents, err := os.ReadFile(configFile) //nolint:gosec
+		if err != nil {
+			return nil, fmt.Errorf("failed to read config file %s (%w)", configFile, err)
+		}
+		profiles := map[string]map[string]interface{}{}
+		if err := yaml.Unmarshal(contents, &profiles); err != nil {
+			return nil, fmt.Errorf("failed to parse config file %s (%w)", configFile, err)
+		}
+		profileValues, ok := profiles[profile]
+		if !ok {
+			return nil, fmt.Errorf("profile %s not found in config file %s", profile, configFile)
+		}
+		values = make(map[string]string, len(profileValues))
+		for key, value := range profileValues {
+			values[key] = fmt.Sprint(value)
+		}
+	default:
+		cfg, err := ini.Load(configFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read config file %s (%w)", configFile, err)
+		}
+		if !cfg.HasSection(profile) {
+			return nil, fmt.Errorf("profile %s not found in config file %s", profile, configFile)
+		}
+		values = cfg.Section(profile).KeysHash()
+	}
+
+	result := make(map[string]string, len(values))
+	for key, value := range values {
+		result[strings.TrimPrefix(strings.ToLower(key), "ovirt_")] = value
+	}
+	return result, nil
+}
+
+func unknownConfigFileKeys(configFile string, profile string, values map[string]string) diag.Diagnostics {
+	var unknownKeys []string
+	for key := range values {
+		found := false
+		for _, fallback := range providerFallbacks {
+			if fallback.fileKey == key {
+				found = true
+				break
+			}
+		}
+		if !found {
+			unknownKeys = append(unknownKeys, key)
+		}
+	}
+	if len(unknownKeys) == 0 {
+		return nil
+	}
+	sort.Strings(unknownKeys)
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Unknown keys in the oVirt config file",
+			Detail: fmt.Sprintf(
+				"Profile %s in %s contains the following unknown keys, which are ignored: %s",
+				profile,
+				configFile,
+				strings.Join(unknownKeys, ", "),
+			),
+		},
+	}
+}
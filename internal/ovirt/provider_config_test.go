@@ -0,0 +1,151 @@
+package ovirt
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestProviderSettingsPrecedence(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "ovirt.ini")
+	if err := os.WriteFile(
+		configFile,
+		[]byte(`[test]
+ovirt_url = https://file.example.com/ovirt-engine/api
+ovirt_username = file@internal
+ovirt_password = file-password
+ovirt_ca_file = /etc/pki/ovirt-engine/ca.pem
+insecure = true
+`),
+		0o600,
+	); err != nil {
+		t.Fatalf("failed to write config file (%v)", err)
+	}
+	t.Setenv("OVIRT_URL", "https://env.example.com/ovirt-engine/api")
+	t.Setenv("OVIRT_USERNAME", "env@internal")
+
+	data := schema.TestResourceDataRaw(
+		t, providerSchema, map[string]interface{}{
+			"url":         "https://config.example.com/ovirt-engine/api",
+			"config_file": configFile,
+			"profile":     "test",
+		},
+	)
+	settings, diags := resolveProviderSettings(data)
+	if diags.HasError() {
+		t.Fatalf("failed to resolve provider settings (%v)", diags)
+	}
+
+	expected := map[string]interface{}{
+		"url":          "https://config.example.com/ovirt-engine/api",
+		"username":     "env@internal",
+		"password":     "file-password",
+		"tls_ca_files": []string{"/etc/pki/ovirt-engine/ca.pem"},
+		"tls_insecure": true,
+	}
+	if !reflect.DeepEqual(settings.values, expected) {
+		t.Fatalf("unexpected provider settings: %v", settings.values)
+	}
+	if source := settings.sources["username"]; source != "environment variable OVIRT_USERNAME" {
+		t.Fatalf("unexpected source for username: %s", source)
+	}
+}
+
+func TestProviderSettingsYAML(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "ovirt.yaml")
+	if err := os.WriteFile(
+		configFile,
+		[]byte(`default:
+  url: https://file.example.com/ovirt-engine/api
+  username: file@internal
+  password: file-password
+  insecure: false
+  colour: blue
+`),
+		0o600,
+	); err != nil {
+		t.Fatalf("failed to write config file (%v)", err)
+	}
+
+	data := schema.TestResourceDataRaw(
+		t, providerSchema, map[string]interface{}{
+			"config_file": configFile,
+		},
+	)
+	settings, diags := resolveProviderSettings(data)
+	if diags.HasError() {
+		t.Fatalf("failed to resolve provider settings (%v)", diags)
+	}
+	if len(diags) != 1 {
+		t.Fatalf("expected a warning about the unknown colour key, got %v", diags)
+	}
+	if settings.values["username"] != "file@internal" || settings.values["tls_insecure"] != false {
+		t.Fatalf("unexpected provider settings: %v", settings.values)
+	}
+}
+
+func TestProviderSettingsMissingProfile(t *testing.T) {
+	t.Parallel()
+
+	configFile := filepath.Join(t.TempDir(), "ovirt.ini")
+	if err := os.WriteFile(configFile, []byte("[default]\nurl = https://example.com\n"), 0o600); err != nil {
+		t.Fatalf("failed to write config file (%v)", err)
+	}
+
+	data := schema.TestResourceDataRaw(
+		t, providerSchema, map[string]interface{}{
+			"config_file": configFile,
+			"profile":     "production",
+		},
+	)
+	if _, diags := resolveProviderSettings(data); !diags.HasError() {
+		t.Fatalf("no error returned for a missing profile")
+	}
+}
+
+func TestProviderSettingsTLSTrust(t *testing.T) {
+	t.Setenv("OVIRT_CAFILE", "")
+	t.Setenv("OVIRT_INSECURE", "")
+
+	testCases := map[string]struct {
+		config   map[string]interface{}
+		caFile   string
+		expected bool
+	}{
+		"none": {
+			config:   map[string]interface{}{},
+			expected: false,
+		},
+		"ca-file-from-environment": {
+			config:   map[string]interface{}{},
+			caFile:   "/etc/pki/ovirt-engine/ca.pem",
+			expected: true,
+		},
+		"insecure": {
+			config:   map[string]interface{}{"tls_insecure": true},
+			expected: true,
+		},
+		"system": {
+			config:   map[string]interface{}{"tls_system": true},
+			expected: true,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(
+			name, func(t *testing.T) {
+				t.Setenv("OVIRT_CAFILE", testCase.caFile)
+				data := schema.TestResourceDataRaw(t, providerSchema, testCase.config)
+				settings, diags := resolveProviderSettings(data)
+				if diags.HasError() {
+					t.Fatalf("failed to resolve provider settings (%v)", diags)
+				}
+				if hasTLSTrust := settings.hasTLSTrust(data); hasTLSTrust != testCase.expected {
+					t.Fatalf("expected hasTLSTrust to return %t, got %t", testCase.expected, hasTLSTrust)
+				}
+			},
+		)
+	}
+}
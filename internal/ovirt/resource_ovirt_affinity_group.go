@@ -100,8 +100,11 @@ func (p *provider) affinityGroupResource() *schema.Resource {
 		CreateContext: p.affinityGroupCreate,
 		ReadContext:   p.affinityGroupRead,
 		DeleteContext: p.affinityGroupDelete,
-		Schema:        affinityGroupSchema,
-		Description:   "The ovirt_affinity_group resource creates affinity groups in oVirt.",
+		Importer: &schema.ResourceImporter{
+			StateContext: p.affinityGroupImport,
+		},
+		Schema:      affinityGroupSchema,
+		Description: "The ovirt_affinity_group resource creates affinity groups in oVirt.",
 	}
 }
 
@@ -244,3 +247,28 @@ func (p *provider) affinityGroupDelete(ctx context.Context, data *schema.Resourc
 	}
 	return nil
 }
+
+func (p *provider) affinityGroupImport(ctx context.Context, data *schema.ResourceData, _ interface{}) (
+	[]*schema.ResourceData,
+	error,
+) {
+	importID := data.Id()
+	parts, err := splitImportID(importID, "/", "ClusterID/AffinityGroupID")
+	if err != nil {
+		return nil, err
+	}
+	client := p.client.WithContext(ctx)
+	ag, err := client.GetAffinityGroup(ovirtclient.ClusterID(parts[0]), ovirtclient.AffinityGroupID(parts[1]))
+	if err != nil {
+		return nil, fmt.Errorf("failed to import affinity group %s (%w)", importID, err)
+	}
+	if err := data.Set("cluster_id", parts[0]); err != nil {
+		return nil, fmt.Errorf("failed to set cluster_id to %s (%w)", parts[0], err)
+	}
+	if err := diagsToError(affinityGroupToData(ag, data)); err != nil {
+		return nil, fmt.Errorf("failed to import affinity group %s (%w)", importID, err)
+	}
+	return []*schema.ResourceData{
+		data,
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAffinityGroupResource(t *testing.T) {
@@ -14,14 +15,8 @@ func TestAffinityGroupResource(t *testing.T) {
 	p := newProvider(newTestLogger(t))
 	clusterID := p.getTestHelper().GetClusterID()
 	name := t.Name() + "_" + p.getTestHelper().GenerateRandomID(5)
-
-	resource.UnitTest(
-		t, resource.TestCase{
-			ProviderFactories: p.getProviderFactories(),
-			Steps: []resource.TestStep{
-				{
-					Config: fmt.Sprintf(
-						`
+	config := fmt.Sprintf(
+		`
 provider "ovirt" {
 	mock = true
 }
@@ -31,9 +26,16 @@ resource "ovirt_affinity_group" "test" {
     name = "%s"
 }
 `,
-						clusterID,
-						name,
-					),
+		clusterID,
+		name,
+	)
+
+	resource.UnitTest(
+		t, resource.TestCase{
+			ProviderFactories: p.getProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: config,
 					Check: resource.ComposeTestCheckFunc(
 						resource.TestMatchResourceAttr(
 							"ovirt_affinity_group.test",
@@ -52,6 +54,16 @@ resource "ovirt_affinity_group" "test" {
 						),
 					),
 				},
+				{
+					Config:            config,
+					ImportState:       true,
+					ImportStateVerify: true,
+					ResourceName:      "ovirt_affinity_group.test",
+					ImportStateIdFunc: func(state *terraform.State) (string, error) {
+						id := state.RootModule().Resources["ovirt_affinity_group.test"].Primary.ID
+						return fmt.Sprintf("%s/%s", clusterID, id), nil
+					},
+				},
 			},
 		},
 	)
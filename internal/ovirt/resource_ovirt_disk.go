@@ -61,27 +61,37 @@ var diskBaseSchema = map[string]*schema.Schema{
 var diskSchema = schemaMerge(
 	diskBaseSchema, map[string]*schema.Schema{
 		"size": {
-			Type:             schema.TypeInt,
+			Type:             schema.TypeString,
 			Required:         true,
-			Description:      "Disk size in bytes.",
-			ValidateDiagFunc: validateDiskSize,
+			Description:      sizeDescription("Disk size.", diskAlignment),
+			ValidateDiagFunc: validateSize(diskAlignment),
+			StateFunc:        normalizeSize,
 			ForceNew:         true,
 		},
 	},
 )
 
 func (p *provider) diskResource() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		CreateContext: p.diskCreate,
 		ReadContext:   p.diskRead,
 		UpdateContext: p.diskUpdate,
 		DeleteContext: p.diskDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: p.diskImport,
 		},
-		Schema:      diskSchema,
-		Description: "The ovirt_disk resource creates disks in oVirt.",
+		Schema:        diskSchema,
+		SchemaVersion: 1,
+		Description:   "The ovirt_disk resource creates disks in oVirt.",
 	}
+	resource.StateUpgraders = []schema.StateUpgrader{sizeStateUpgrader(resource, "size")}
+	return resource
 }
 
 func (p *provider) diskCreate(
@@ -94,13 +104,13 @@ func (p *provider) diskCreate(
 
 	storageDomainID := data.Get("storage_domain_id").(string)
 	format := data.Get("format").(string)
-	size := data.Get("size").(int)
-	if size < 0 {
+	size, err := sizeValue(data.Get("size"))
+	if err != nil {
 		return diag.Diagnostics{
 			diag.Diagnostic{
 				Severity: diag.Error,
 				Summary:  "Invalid disk size.",
-				Detail:   "Disk size must be a non-negative integer.",
+				Detail:   err.Error(),
 			},
 		}
 	}
@@ -136,7 +146,7 @@ func (p *provider) diskCreate(
 	disk, err := client.CreateDisk(
 		ovirtclient.StorageDomainID(storageDomainID),
 		ovirtclient.ImageFormat(format),
-		uint64(size),
+		size,
 		params,
 	)
 	if err != nil {
@@ -157,12 +167,16 @@ func diskResourceUpdate(disk ovirtclient.Disk, data *schema.ResourceData) diag.D
 	data.SetId(string(disk.ID()))
 	diags = setResourceField(data, "alias", disk.Alias(), diags)
 	diags = setResourceField(data, "format", string(disk.Format()), diags)
-	diags = setResourceField(data, "size", disk.ProvisionedSize(), diags)
+	diags = setResourceField(data, "size", formatSize(disk.ProvisionedSize()), diags)
 	diags = setResourceField(data, "sparse", disk.Sparse(), diags)
 	diags = setResourceField(data, "total_size", disk.TotalSize(), diags)
 	diags = setResourceField(data, "status", disk.Status(), diags)
 
 	desiredStorageDomainID := ovirtclient.StorageDomainID(data.Get("storage_domain_id").(string))
+	if desiredStorageDomainID == "" && len(disk.StorageDomainIDs()) != 0 {
+		// The disk is being imported, use the storage domain it is on.
+		desiredStorageDomainID = disk.StorageDomainIDs()[0]
+	}
 	foundStorageDomain := false
 	for _, storageDomainID := range disk.StorageDomainIDs() {
 		if desiredStorageDomainID == storageDomainID {
@@ -254,6 +268,13 @@ func (p *provider) diskImport(ctx context.Context, data *schema.ResourceData, _
 	error,
 ) {
 	client := p.client.WithContext(ctx)
+	if isImportQuery(data.Id()) {
+		diskID, err := findDiskForImport(client, data.Id())
+		if err != nil {
+			return nil, fmt.Errorf("failed to import disk (%w)", err)
+		}
+		data.SetId(string(diskID))
+	}
 	disk, err := client.GetDisk(ovirtclient.DiskID(data.Id()))
 	if err != nil {
 		return nil, fmt.Errorf("failed to import disk %s (%w)", data.Id(), err)
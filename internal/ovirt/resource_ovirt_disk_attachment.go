@@ -60,6 +60,11 @@ func (p *provider) diskAttachmentResource() *schema.Resource {
 		CreateContext: p.diskAttachmentCreate,
 		ReadContext:   p.diskAttachmentRead,
 		DeleteContext: p.diskAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: p.diskAttachmentImport,
 		},
@@ -177,13 +182,24 @@ func (p *provider) diskAttachmentImport(
 	parts := strings.SplitN(importID, "/", 2)
 	if len(parts) != 2 {
 		return nil, fmt.Errorf(
-			"invalid import specification, the ID should be specified as: VMID/DiskAttachmentID",
+			"invalid import specification, the ID should be specified as: VMID/DiskAttachmentID, where the VM may also be specified as name=VMName[,cluster=Cluster] and the disk attachment as name=DiskAlias",
 		)
 	}
-	attachment, err := client.GetDiskAttachment(
-		ovirtclient.VMID(parts[0]),
-		ovirtclient.DiskAttachmentID(parts[1]),
-	)
+	vmID := ovirtclient.VMID(parts[0])
+	if isImportQuery(parts[0]) {
+		var err error
+		if vmID, err = findVMForImport(client, parts[0]); err != nil {
+			return nil, fmt.Errorf("failed to import disk_attachment %s (%w)", importID, err)
+		}
+	}
+	diskAttachmentID := ovirtclient.DiskAttachmentID(parts[1])
+	if isImportQuery(parts[1]) {
+		var err error
+		if diskAttachmentID, err = findDiskAttachmentForImport(client, vmID, parts[1]); err != nil {
+			return nil, fmt.Errorf("failed to import disk_attachment %s (%w)", importID, err)
+		}
+	}
+	attachment, err := client.GetDiskAttachment(vmID, diskAttachmentID)
 	if isNotFound(err) {
 		return nil, fmt.Errorf("disk attachment with the specified VMID/ID %s not found (%w)", importID, err)
 	}
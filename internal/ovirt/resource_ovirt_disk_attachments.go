@@ -91,6 +91,12 @@ func (p *provider) diskAttachmentsResource() *schema.Resource {
 		ReadContext:   p.diskAttachmentsRead,
 		UpdateContext: p.diskAttachmentsCreateOrUpdate,
 		DeleteContext: p.diskAttachmentsDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: p.diskAttachmentsImport,
 		},
@@ -158,7 +164,7 @@ func (p *provider) cleanUnmanagedDiskAttachments(
 			}
 		}
 		if !found {
-			if err := attachment.Remove(); err != nil {
+			if err := client.RemoveDiskAttachment(attachment.VMID(), attachment.ID()); err != nil {
 				diags = append(
 					diags,
 					errorToDiag(
@@ -216,7 +222,7 @@ func (p *provider) createOrUpdateDiskAttachment(
 			foundExisting.Active() == active {
 			return nil
 		}
-		if err := foundExisting.Remove(); err != nil && !isNotFound(err) {
+		if err := client.RemoveDiskAttachment(foundExisting.VMID(), foundExisting.ID()); err != nil && !isNotFound(err) {
 			return errorToDiags(
 				fmt.Sprintf("remove existing disk interface %s", foundExisting.ID()),
 				err,
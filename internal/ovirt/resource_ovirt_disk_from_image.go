@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,7 +23,7 @@ var diskFromImageSchema = schemaMerge(
 			ValidateDiagFunc: validateLocalFile,
 		},
 		"size": {
-			Type:        schema.TypeInt,
+			Type:        schema.TypeString,
 			Computed:    true,
 			Description: "Disk size in bytes.",
 		},
@@ -30,14 +31,26 @@ var diskFromImageSchema = schemaMerge(
 )
 
 func (p *provider) diskFromImageResource() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		CreateContext: p.diskFromImageCreate,
 		ReadContext:   p.diskRead,
 		UpdateContext: p.diskUpdate,
 		DeleteContext: p.diskDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: p.diskFromImageImport,
+		},
 		Schema:        diskFromImageSchema,
+		SchemaVersion: 1,
 		Description:   "The ovirt_disk_from_image resource creates disks in oVirt from a local image file.",
 	}
+	resource.StateUpgraders = []schema.StateUpgrader{sizeStateUpgrader(resource, "size")}
+	return resource
 }
 
 func (p *provider) diskFromImageCreate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
@@ -113,7 +126,7 @@ func (p *provider) diskFromImageCreate(ctx context.Context, data *schema.Resourc
 			},
 		}
 		if disk != nil {
-			if err := disk.Remove(); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+			if err := client.RemoveDisk(disk.ID()); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
 				diags = append(
 					diags, diag.Diagnostic{
 						Severity: diag.Error,
@@ -127,3 +140,30 @@ func (p *provider) diskFromImageCreate(ctx context.Context, data *schema.Resourc
 	}
 	return diskResourceUpdate(disk, data)
 }
+
+// diskFromImageImport imports a disk by its ID. The image file the disk was uploaded from cannot be determined from the
+// engine, so it may be passed after the disk ID as DiskID:SourceFile.
+func (p *provider) diskFromImageImport(ctx context.Context, data *schema.ResourceData, _ interface{}) (
+	[]*schema.ResourceData,
+	error,
+) {
+	importID := data.Id()
+	parts := strings.SplitN(importID, ":", 2)
+	data.SetId(parts[0])
+	if len(parts) == 2 {
+		if err := data.Set("source_file", parts[1]); err != nil {
+			return nil, fmt.Errorf("failed to set source_file to %s (%w)", parts[1], err)
+		}
+	}
+	client := p.client.WithContext(ctx)
+	disk, err := client.GetDisk(ovirtclient.DiskID(data.Id()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to import disk %s (%w)", importID, err)
+	}
+	if err := diagsToError(diskResourceUpdate(disk, data)); err != nil {
+		return nil, fmt.Errorf("failed to import disk %s (%w)", importID, err)
+	}
+	return []*schema.ResourceData{
+		data,
+	}, nil
+}
@@ -1,11 +1,13 @@
 package ovirt
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestImageUpload(t *testing.T) {
@@ -52,3 +54,40 @@ resource "ovirt_disk_from_image" "foo" {
 		},
 	)
 }
+
+func TestDiskFromImageResourceData(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(newTestLogger(t)).(*provider)
+	providerData := schema.TestResourceDataRaw(t, providerSchema, map[string]interface{}{"mock": true})
+	if _, diags := p.configureProvider(context.Background(), providerData); diags.HasError() {
+		t.Fatalf("failed to configure provider (%v)", diags)
+	}
+
+	data := schema.TestResourceDataRaw(
+		t, diskFromImageSchema, map[string]interface{}{
+			"storage_domain_id": string(p.getTestHelper().GetStorageDomainID()),
+			"format":            "raw",
+			"source_file":       "./testimage/image",
+		},
+	)
+	if diags := p.diskFromImageCreate(context.Background(), data, nil); diags.HasError() {
+		t.Fatalf("failed to create disk (%v)", diags)
+	}
+	if size := data.Get("size"); size != fmt.Sprintf("%d", 1024*1024) {
+		t.Fatalf("incorrect size after create: %v", size)
+	}
+	if diags := p.diskRead(context.Background(), data, nil); diags.HasError() {
+		t.Fatalf("failed to read disk (%v)", diags)
+	}
+
+	importData := p.diskFromImageResource().Data(nil)
+	importData.SetId(data.Id() + ":./testimage/image")
+	imported, err := p.diskFromImageImport(context.Background(), importData, nil)
+	if err != nil {
+		t.Fatalf("failed to import disk (%v)", err)
+	}
+	if size := imported[0].Get("size"); size != data.Get("size") {
+		t.Fatalf("incorrect size after import: %v", size)
+	}
+}
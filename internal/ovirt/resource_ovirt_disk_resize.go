@@ -2,6 +2,7 @@ package ovirt
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,25 +18,37 @@ var diskResizeSchema = map[string]*schema.Schema{
 		ValidateDiagFunc: validateUUID,
 	},
 	"size": {
-		Type:             schema.TypeInt,
+		Type:             schema.TypeString,
 		Required:         true,
 		ForceNew:         true,
-		Description:      "Disk size in bytes.",
-		ValidateDiagFunc: validateDiskSize,
+		Description:      sizeDescription("Disk size.", diskAlignment),
+		ValidateDiagFunc: validateSize(diskAlignment),
+		StateFunc:        normalizeSize,
 	},
 }
 
 func (p *provider) diskResizeResource() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		CreateContext: p.diskResizeCreate,
 		ReadContext:   p.diskResizeRead,
 		DeleteContext: p.diskResizeDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: p.diskResizeImport,
+		},
 		Schema:        diskResizeSchema,
+		SchemaVersion: 1,
 		Description: `The ovirt_disk_resize resource resizes disks in oVirt to the specified size. 
 		
 ~> Only use this resource with disks created from templates. Otherwise, two terraform resources will handle the same disk resource.  
 		`,
 	}
+	resource.StateUpgraders = []schema.StateUpgrader{sizeStateUpgrader(resource, "size")}
+	return resource
 }
 
 func (p *provider) diskResizeCreate(
@@ -64,11 +77,28 @@ func (p *provider) diskResizeRead(ctx context.Context, data *schema.ResourceData
 
 	data.SetId(diskID)
 	diags := diag.Diagnostics{}
-	diags = setResourceField(data, "size", disk.ProvisionedSize(), diags)
+	diags = setResourceField(data, "size", formatSize(disk.ProvisionedSize()), diags)
 
 	return diags
 }
 
+func (p *provider) diskResizeImport(
+	ctx context.Context,
+	data *schema.ResourceData,
+	_ interface{},
+) ([]*schema.ResourceData, error) {
+	diskID := data.Id()
+	if err := data.Set("disk_id", diskID); err != nil {
+		return nil, fmt.Errorf("failed to set disk_id to %s (%w)", diskID, err)
+	}
+	if err := diagsToError(p.diskResizeRead(ctx, data, nil)); err != nil {
+		return nil, fmt.Errorf("failed to import disk size of disk %s (%w)", diskID, err)
+	}
+	return []*schema.ResourceData{
+		data,
+	}, nil
+}
+
 func (p *provider) diskResizeDelete(_ context.Context, data *schema.ResourceData, _ interface{}) diag.Diagnostics {
 	data.SetId("")
 	return nil
@@ -76,18 +106,18 @@ func (p *provider) diskResizeDelete(_ context.Context, data *schema.ResourceData
 
 func resizeDisk(client ovirtclient.Client, data *schema.ResourceData) diag.Diagnostics {
 	diskID := data.Get("disk_id").(string)
-	newSize := data.Get("size").(int)
-	if newSize < 0 {
+	newSize, err := sizeValue(data.Get("size"))
+	if err != nil {
 		return diag.Diagnostics{
 			diag.Diagnostic{
 				Severity: diag.Error,
-				Summary:  "Invalid disk newSize.",
-				Detail:   "Disk newSize must be a non-negative integer.",
+				Summary:  "Invalid disk size.",
+				Detail:   err.Error(),
 			},
 		}
 	}
 	params := ovirtclient.UpdateDiskParams()
-	_, err := params.WithProvisionedSize(uint64(newSize))
+	_, err = params.WithProvisionedSize(newSize)
 	if err != nil {
 		return diag.Diagnostics{
 			diag.Diagnostic{
@@ -102,15 +132,7 @@ func resizeDisk(client ovirtclient.Client, data *schema.ResourceData) diag.Diagn
 		Severity: diag.Error,
 		Summary:  "Failed to update disk size.",
 	}
-	diskUpdate, err := client.StartUpdateDisk(ovirtclient.DiskID(diskID), params)
-	if err != nil {
-		if isNotFound(err) {
-			data.SetId("")
-		}
-		updateFailedDiag.Detail = err.Error()
-		return diag.Diagnostics{updateFailedDiag}
-	}
-	_, err = diskUpdate.Wait()
+	_, err = client.UpdateDisk(ovirtclient.DiskID(diskID), params)
 	if err != nil {
 		if isNotFound(err) {
 			data.SetId("")
@@ -121,7 +143,7 @@ func resizeDisk(client ovirtclient.Client, data *schema.ResourceData) diag.Diagn
 
 	data.SetId(diskID)
 	diags := diag.Diagnostics{}
-	diags = setResourceField(data, "size", newSize, diags)
+	diags = setResourceField(data, "size", formatSize(newSize), diags)
 
 	return diags
 }
@@ -22,20 +22,22 @@ func TestDiskResizeResource(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	config := fmt.Sprintf(`
+		provider "ovirt" {
+			mock = true
+		}
+		resource "ovirt_disk_resize" "resized" {
+			disk_id = "%s"
+			size = 2*1048576
+		}`,
+		disk.ID(),
+	)
+
 	resource.UnitTest(t, resource.TestCase{
 		ProviderFactories: p.getProviderFactories(),
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(`
-					provider "ovirt" {
-						mock = true
-					}
-					resource "ovirt_disk_resize" "resized" {
-						disk_id = "%s"
-						size = 2*1048576
-					}`,
-					disk.ID(),
-				),
+				Config: config,
 				Check: resource.ComposeTestCheckFunc(
 					func(state *terraform.State) error {
 						diskID := state.RootModule().Resources["ovirt_disk_resize.resized"].Primary.ID
@@ -50,6 +52,12 @@ func TestDiskResizeResource(t *testing.T) {
 					},
 				),
 			},
+			{
+				Config:            config,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      "ovirt_disk_resize.resized",
+			},
 		},
 	})
 }
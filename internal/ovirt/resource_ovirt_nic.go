@@ -19,7 +19,6 @@ var nicSchema = map[string]*schema.Schema{
 		Type:             schema.TypeString,
 		Required:         true,
 		Description:      "ID of the VNIC profile to associate with this NIC.",
-		ForceNew:         true,
 		ValidateDiagFunc: validateUUID,
 	},
 	"vm_id": {
@@ -33,14 +32,13 @@ var nicSchema = map[string]*schema.Schema{
 		Type:             schema.TypeString,
 		Required:         true,
 		Description:      "Human-readable name for the NIC.",
-		ForceNew:         true,
 		ValidateDiagFunc: validateNonEmpty,
 	},
 	"mac": {
 		Type:             schema.TypeString,
 		Optional:         true,
-		Description:      "Custom Mac Address for the NIC.",
-		ForceNew:         true,
+		Computed:         true,
+		Description:      "Custom Mac Address for the NIC. If not set, the engine assigns a Mac Address from its pool.",
 		ValidateDiagFunc: validateMacAddress,
 	},
 }
@@ -49,6 +47,7 @@ func (p *provider) nicResource() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: p.nicCreate,
 		ReadContext:   p.nicRead,
+		UpdateContext: p.nicUpdate,
 		DeleteContext: p.nicDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: p.nicImport,
@@ -110,6 +109,40 @@ func (p *provider) nicRead(ctx context.Context, data *schema.ResourceData, _ int
 	return nicResourceUpdate(nic, data)
 }
 
+func (p *provider) nicUpdate(ctx context.Context, data *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	client := p.client.WithContext(ctx)
+	params := ovirtclient.UpdateNICParams()
+	var err error
+	if data.HasChange("name") {
+		if params, err = params.WithName(data.Get("name").(string)); err != nil {
+			return errorToDiags("set name", err)
+		}
+	}
+	if data.HasChange("vnic_profile_id") {
+		vnicProfileID := ovirtclient.VNICProfileID(data.Get("vnic_profile_id").(string))
+		if params, err = params.WithVNICProfileID(vnicProfileID); err != nil {
+			return errorToDiags("set VNIC profile ID", err)
+		}
+	}
+	if mac, ok := data.GetOk("mac"); ok && data.HasChange("mac") {
+		if params, err = params.WithMac(mac.(string)); err != nil {
+			return errorToDiags("set Mac Address", err)
+		}
+	}
+	nic, err := client.UpdateNIC(
+		ovirtclient.VMID(data.Get("vm_id").(string)),
+		ovirtclient.NICID(data.Id()),
+		params,
+	)
+	if err != nil {
+		if isNotFound(err) {
+			data.SetId("")
+		}
+		return errorToDiags("update NIC", err)
+	}
+	return nicResourceUpdate(nic, data)
+}
+
 func (p *provider) nicDelete(ctx context.Context, data *schema.ResourceData, _ interface{}) diag.Diagnostics {
 	client := p.client.WithContext(ctx)
 	id := data.Id()
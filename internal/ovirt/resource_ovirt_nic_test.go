@@ -134,3 +134,89 @@ resource "ovirt_nic" "test" {
 		},
 	})
 }
+
+func TestNICResourceUpdate(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(newTestLogger(t))
+	clusterID := p.getTestHelper().GetClusterID()
+	templateID := p.getTestHelper().GetBlankTemplateID()
+	vnicProfileID := p.getTestHelper().GetVNICProfileID()
+	networkID := getTestNetworkID(t, p)
+	vnicProfileName := fmt.Sprintf("%s-%s", t.Name(), p.getTestHelper().GenerateRandomID(5))
+
+	configTemplate := `
+provider "ovirt" {
+	mock = true
+}
+
+resource "ovirt_vm" "test" {
+	cluster_id  = "%s"
+	template_id = "%s"
+	name        = "test"
+}
+
+resource "ovirt_vnic_profile" "test" {
+	name       = "%s"
+	network_id = "%s"
+}
+
+resource "ovirt_nic" "test" {
+	vm_id           = ovirt_vm.test.id
+	vnic_profile_id = %s
+	name            = "%s"
+	mac             = "%s"
+}
+`
+
+	var nicID string
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: p.getProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(
+					configTemplate,
+					clusterID,
+					templateID,
+					vnicProfileName,
+					networkID,
+					fmt.Sprintf("%q", vnicProfileID),
+					"eth0",
+					"11:22:33:AA:BB:CC",
+				),
+				Check: func(state *terraform.State) error {
+					nicID = state.RootModule().Resources["ovirt_nic.test"].Primary.ID
+					return nil
+				},
+			},
+			{
+				Config: fmt.Sprintf(
+					configTemplate,
+					clusterID,
+					templateID,
+					vnicProfileName,
+					networkID,
+					"ovirt_vnic_profile.test.id",
+					"eth1",
+					"11:22:33:AA:BB:DD",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"ovirt_nic.test",
+						"vnic_profile_id",
+						"ovirt_vnic_profile.test",
+						"id",
+					),
+					resource.TestCheckResourceAttr("ovirt_nic.test", "name", "eth1"),
+					resource.TestCheckResourceAttr("ovirt_nic.test", "mac", "11:22:33:AA:BB:DD"),
+					func(state *terraform.State) error {
+						if id := state.RootModule().Resources["ovirt_nic.test"].Primary.ID; id != nicID {
+							return fmt.Errorf("the NIC was recreated instead of updated in place (%s != %s)", id, nicID)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
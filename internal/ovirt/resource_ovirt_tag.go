@@ -35,8 +35,11 @@ func (p *provider) tagResource() *schema.Resource {
 		CreateContext: p.tagCreate,
 		ReadContext:   p.tagRead,
 		DeleteContext: p.tagDelete,
-		Schema:        tagSchema,
-		Description:   "The ovirt_tag resource creates tags for virtual machines to use.",
+		Importer: &schema.ResourceImporter{
+			StateContext: p.tagImport,
+		},
+		Schema:      tagSchema,
+		Description: "The ovirt_tag resource creates tags for virtual machines to use.",
 	}
 }
 
@@ -80,6 +83,22 @@ func (p *provider) tagRead(ctx context.Context, data *schema.ResourceData, i int
 	return diags
 }
 
+func (p *provider) tagImport(ctx context.Context, data *schema.ResourceData, _ interface{}) (
+	[]*schema.ResourceData,
+	error,
+) {
+	tagID := data.Id()
+	if err := diagsToError(p.tagRead(ctx, data, nil)); err != nil {
+		return nil, fmt.Errorf("failed to import tag %s (%w)", tagID, err)
+	}
+	if data.Id() == "" {
+		return nil, fmt.Errorf("tag %s not found", tagID)
+	}
+	return []*schema.ResourceData{
+		data,
+	}, nil
+}
+
 func (p *provider) tagDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client := p.client.WithContext(ctx)
 	if err := client.RemoveTag(
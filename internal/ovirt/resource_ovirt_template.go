@@ -43,6 +43,11 @@ func (p *provider) templateResource() *schema.Resource {
 		CreateContext: p.templateCreate,
 		ReadContext:   p.templateRead,
 		DeleteContext: p.templateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: p.templateImport,
 		},
@@ -154,6 +159,13 @@ func (p *provider) templateImport(ctx context.Context, data *schema.ResourceData
 	error,
 ) {
 	client := p.client.WithContext(ctx)
+	if isImportQuery(data.Id()) {
+		templateID, err := findTemplateForImport(client, data.Id())
+		if err != nil {
+			return nil, fmt.Errorf("failed to import template (%w)", err)
+		}
+		data.SetId(string(templateID))
+	}
 
 	template, err := client.GetTemplate(ovirtclient.TemplateID(data.Id()))
 	if err != nil {
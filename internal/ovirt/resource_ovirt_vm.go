@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
@@ -58,6 +59,7 @@ var vmSchema = map[string]*schema.Schema{
 	"cpu_mode": {
 		Type:     schema.TypeString,
 		Optional: true,
+		Computed: true,
 		ForceNew: true,
 		Description: fmt.Sprintf(
 			"Sets the CPU mode for the VM. Can be one of: %s",
@@ -68,6 +70,7 @@ var vmSchema = map[string]*schema.Schema{
 	"cpu_cores": {
 		Type:             schema.TypeInt,
 		Optional:         true,
+		Computed:         true,
 		ForceNew:         true,
 		RequiredWith:     []string{"cpu_sockets", "cpu_threads"},
 		Description:      "Number of CPU cores to allocate to the VM. If set, cpu_threads and cpu_sockets must also be specified.",
@@ -76,6 +79,7 @@ var vmSchema = map[string]*schema.Schema{
 	"cpu_threads": {
 		Type:             schema.TypeInt,
 		Optional:         true,
+		Computed:         true,
 		ForceNew:         true,
 		RequiredWith:     []string{"cpu_sockets", "cpu_cores"},
 		Description:      "Number of CPU threads to allocate to the VM. If set, cpu_cores and cpu_sockets must also be specified.",
@@ -84,6 +88,7 @@ var vmSchema = map[string]*schema.Schema{
 	"cpu_sockets": {
 		Type:             schema.TypeInt,
 		Optional:         true,
+		Computed:         true,
 		ForceNew:         true,
 		RequiredWith:     []string{"cpu_threads", "cpu_cores"},
 		Description:      "Number of CPU sockets to allocate to the VM. If set, cpu_cores and cpu_threads must also be specified.",
@@ -98,6 +103,7 @@ var vmSchema = map[string]*schema.Schema{
 	"vm_type": {
 		Type:             schema.TypeString,
 		Optional:         true,
+		Computed:         true,
 		ForceNew:         true,
 		Description:      "Virtual machine type. Must be one of: " + strings.Join(vmTypeValues(), ", "),
 		ValidateDiagFunc: validateEnum(vmTypeValues()),
@@ -159,20 +165,42 @@ var vmSchema = map[string]*schema.Schema{
 		},
 	},
 	"initialization_custom_script": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		ForceNew:    true,
-		Description: "Custom script that passed to VM during initialization.",
+		Type:          schema.TypeString,
+		Optional:      true,
+		Computed:      true,
+		ForceNew:      true,
+		Sensitive:     true,
+		ConflictsWith: []string{"initialization_custom_script_wo"},
+		Description:   "Custom script that passed to VM during initialization. Use `initialization_custom_script_wo` to keep the script out of the state. Switching to `initialization_custom_script_wo` replaces the VM and removes the script from the state.",
+	},
+	"initialization_custom_script_wo": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		WriteOnly:     true,
+		Sensitive:     true,
+		ConflictsWith: []string{"initialization_custom_script"},
+		RequiredWith:  []string{"initialization_custom_script_wo_version"},
+		Description:   "Write-only variant of `initialization_custom_script`, which is sent to the engine when the VM is created, but never stored in the state or plan. Requires Terraform 1.11 or later.",
+	},
+	"initialization_custom_script_wo_version": {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		ForceNew:         true,
+		RequiredWith:     []string{"initialization_custom_script_wo"},
+		ValidateDiagFunc: validatePositiveInt,
+		Description:      "Version of `initialization_custom_script_wo`. Terraform can't detect changes of write-only attributes, so increment the version to recreate the VM with a changed script.",
 	},
 	"initialization_hostname": {
 		Type:        schema.TypeString,
 		Optional:    true,
+		Computed:    true,
 		ForceNew:    true,
 		Description: "hostname that is set during initialization.",
 	},
 	"initialization_nic": {
 		Type:     schema.TypeList,
 		Optional: true,
+		Computed: true,
 		MaxItems: 1,
 		ForceNew: true,
 		Elem: &schema.Resource{
@@ -228,22 +256,27 @@ var vmSchema = map[string]*schema.Schema{
 		Description: "Initial NIC configuration.",
 	},
 	"memory": {
-		Type:             schema.TypeInt,
+		Type:             schema.TypeString,
 		Optional:         true,
-		Description:      "Memory to assign to the VM in bytes.",
-		ValidateDiagFunc: validatePositiveInt,
+		Computed:         true,
+		Description:      sizeDescription("Memory to assign to the VM.", memoryAlignment),
+		ValidateDiagFunc: validateSize(memoryAlignment),
+		StateFunc:        normalizeSize,
 	},
 	"maximum_memory": {
-		Type:             schema.TypeInt,
+		Type:             schema.TypeString,
 		Optional:         true,
+		Computed:         true,
 		ForceNew:         true,
-		Description:      "Maximum memory to assign to the VM in the memory policy in bytes.",
-		ValidateDiagFunc: validatePositiveInt,
+		Description:      sizeDescription("Maximum memory to assign to the VM in the memory policy.", memoryAlignment),
+		ValidateDiagFunc: validateSize(memoryAlignment),
+		StateFunc:        normalizeSize,
 		RequiredWith:     []string{"memory"},
 	},
 	"memory_ballooning": {
 		Type:        schema.TypeBool,
 		Optional:    true,
+		Computed:    true,
 		ForceNew:    true,
 		Description: "Turn memory ballooning on or off for the VM.",
 	},
@@ -256,12 +289,14 @@ var vmSchema = map[string]*schema.Schema{
 	"soundcard_enabled": {
 		Type:        schema.TypeBool,
 		Optional:    true,
+		Computed:    true,
 		ForceNew:    true,
-		Description: "Enable or disable the soundcard.",
+		Description: "Enable or disable the soundcard. Defaults to the setting of the template.",
 	},
 	"instance_type_id": {
 		Type:             schema.TypeString,
 		Optional:         true,
+		ForceNew:         true,
 		Description:      "Defines the VM instance type ID overrides the hardware parameters of the created VM.",
 		ValidateDiagFunc: validateUUID,
 	},
@@ -273,6 +308,7 @@ var vmSchema = map[string]*schema.Schema{
 	"huge_pages": {
 		Type:             schema.TypeInt,
 		Optional:         true,
+		Computed:         true,
 		ForceNew:         true,
 		Description:      "Sets the HugePages setting for the VM. Must be one of: " + strings.Join(vmHugePagesValues(), ", "),
 		ValidateDiagFunc: validateHugePages,
@@ -320,17 +356,149 @@ func vmHugePagesValues() []string {
 }
 
 func (p *provider) vmResource() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		CreateContext: p.vmCreate,
 		ReadContext:   p.vmRead,
 		UpdateContext: p.vmUpdate,
 		DeleteContext: p.vmDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: p.vmImport,
 		},
-		Schema:      vmSchema,
-		Description: "The ovirt_vm resource creates a virtual machine in oVirt.",
+		CustomizeDiff: p.vmCustomizeDiff,
+		Schema:        vmSchema,
+		SchemaVersion: 1,
+		Description:   "The ovirt_vm resource creates a virtual machine in oVirt.",
+	}
+	resource.StateUpgraders = []schema.StateUpgrader{sizeStateUpgrader(resource, "memory", "maximum_memory")}
+	return resource
+}
+
+// vmCustomizeDiff checks cross-field and engine-backed constraints at plan time so that mistakes are not only caught
+// halfway through vmCreate.
+func (p *provider) vmCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	diags := vmMemoryDiffDiags(diff)
+	for _, attribute := range []string{"placement_policy_affinity", "placement_policy_host_ids"} {
+		if _, ok := diff.GetOk(attribute); !ok {
+			continue
+		}
+		if err := p.engine.requireFeature(ovirtclient.FeaturePlacementPolicy, "ovirt_vm", attribute); err != nil {
+			diags = append(diags, attributeDiag(attribute, err.Error()))
+		}
+	}
+	// The provider may not be configured yet, for example while validating a configuration.
+	if p.client != nil {
+		diags = append(diags, vmReferenceDiffDiags(p.client.WithContext(ctx), diff)...)
+	}
+	if err := vmWriteOnlyScriptDiff(diff); err != nil {
+		diags = append(diags, attributeDiag("initialization_custom_script", err.Error()))
 	}
+	return customizeDiffError("ovirt_vm", diags)
+}
+
+// vmWriteOnlyScriptDiff clears initialization_custom_script when the write-only variant is used. The attribute is
+// computed, so a script that was configured before switching to initialization_custom_script_wo would otherwise stay
+// in the planned state.
+func vmWriteOnlyScriptDiff(diff *schema.ResourceDiff) error {
+	if _, ok := diff.GetOk("initialization_custom_script_wo_version"); !ok {
+		return nil
+	}
+	return diff.SetNew("initialization_custom_script", "")
+}
+
+// vmMemoryDiffDiags checks memory against maximum_memory and huge_pages when one of them changes. Unknown and unset
+// values are skipped.
+func vmMemoryDiffDiags(diff *schema.ResourceDiff) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !diff.HasChanges("memory", "maximum_memory", "huge_pages") || !diff.NewValueKnown("memory") {
+		return nil
+	}
+	// Invalid sizes are reported by the attribute validation.
+	memory, err := sizeValue(diff.Get("memory"))
+	if err != nil || memory == 0 {
+		return nil
+	}
+	if diff.NewValueKnown("maximum_memory") {
+		if maxMemory, err := sizeValue(diff.Get("maximum_memory")); err == nil && maxMemory > 0 && memory > maxMemory {
+			diags = append(
+				diags,
+				attributeDiag(
+					"memory",
+					fmt.Sprintf("%d bytes of memory exceed the maximum_memory of %d bytes", memory, maxMemory),
+				),
+			)
+		}
+	}
+	if diff.NewValueKnown("huge_pages") {
+		// huge_pages is in KiB, memory is in bytes.
+		if hugePages := uint64(diff.Get("huge_pages").(int)); hugePages > 0 && memory%(hugePages*1024) != 0 {
+			diags = append(
+				diags,
+				attributeDiag(
+					"huge_pages",
+					fmt.Sprintf(
+						"%d bytes of memory are not a multiple of the %d KiB huge page size",
+						memory,
+						hugePages,
+					),
+				),
+			)
+		}
+	}
+	return diags
+}
+
+// vmReferenceDiffDiags checks that the cluster, template and instance type the VM refers to exist. References are
+// only checked when they change to avoid engine calls on every plan.
+//
+// The oVirt client library exposes neither the data center of a template nor the CPU topology limits of a cluster,
+// so a template from a different data center and an oversized CPU topology are still only reported by the engine
+// when the VM is created.
+func vmReferenceDiffDiags(client ovirtclient.Client, diff *schema.ResourceDiff) diag.Diagnostics {
+	var diags diag.Diagnostics
+	check := func(attribute string, objectType string, get func(id string) error) {
+		if !diff.HasChange(attribute) || !diff.NewValueKnown(attribute) {
+			return
+		}
+		id := diff.Get(attribute).(string)
+		if id == "" {
+			return
+		}
+		if err := get(id); err != nil {
+			if isNotFound(err) {
+				diags = append(diags, attributeDiag(attribute, fmt.Sprintf("%s %s does not exist", objectType, id)))
+			} else {
+				diags = append(
+					diags,
+					attributeDiag(attribute, fmt.Sprintf("failed to fetch %s %s (%v)", objectType, id, err)),
+				)
+			}
+		}
+	}
+	check(
+		"cluster_id", "cluster", func(id string) error {
+			_, err := client.GetCluster(ovirtclient.ClusterID(id))
+			return err
+		},
+	)
+	check(
+		"template_id", "template", func(id string) error {
+			_, err := client.GetTemplate(ovirtclient.TemplateID(id))
+			return err
+		},
+	)
+	check(
+		"instance_type_id", "instance type", func(id string) error {
+			_, err := client.GetInstanceType(ovirtclient.InstanceTypeID(id))
+			return err
+		},
+	)
+	return diags
 }
 
 func (p *provider) vmCreate(
@@ -509,7 +677,11 @@ func handleVMMemoryPolicy(
 	maxMemory, ok := data.GetOk("maximum_memory")
 	if ok {
 		var err error
-		_, err = memoryPolicy.WithMax(int64(maxMemory.(int)))
+		var size uint64
+		if size, err = sizeValue(maxMemory); err == nil {
+			//nolint:gosec // G115: parseSize rejects sizes larger than math.MaxInt64
+			_, err = memoryPolicy.WithMax(int64(size))
+		}
 		if err != nil {
 			diags = append(diags, errorToDiag("add maximum memory", err))
 		} else {
@@ -546,7 +718,11 @@ func handleVMMemory(
 		return diags
 	}
 	var err error
-	_, err = params.WithMemory(int64(memory.(int)))
+	var size uint64
+	if size, err = sizeValue(memory); err == nil {
+		//nolint:gosec // G115: parseSize rejects sizes larger than math.MaxInt64
+		_, err = params.WithMemory(int64(size))
+	}
 	if err != nil {
 		diags = append(diags, errorToDiag("set memory", err))
 	}
@@ -748,6 +924,16 @@ func handleVMInitialization(
 		vmInitScript = hInitScript.(string)
 		useInit = true
 	}
+	// Write-only attributes are only available in the raw config. The version is required with the script.
+	if _, ok := data.GetOk("initialization_custom_script_wo_version"); ok {
+		writeOnlyScript, writeOnlyDiags := data.GetRawConfigAt(cty.GetAttrPath("initialization_custom_script_wo"))
+		diags = append(diags, writeOnlyDiags...)
+		if !writeOnlyDiags.HasError() && writeOnlyScript.Type() == cty.String && writeOnlyScript.IsKnown() &&
+			!writeOnlyScript.IsNull() {
+			vmInitScript = writeOnlyScript.AsString()
+			useInit = true
+		}
+	}
 
 	if hInitNicConfiguration, ok := data.GetOk("initialization_nic"); ok {
 		nicConfiguration, diags = getNicConfiguration(hInitNicConfiguration, diags)
@@ -986,16 +1172,101 @@ func vmResourceUpdate(vm ovirtclient.VMData, data *schema.ResourceData) diag.Dia
 	if _, ok := data.GetOk("os_type"); ok || vm.OS().Type() != "other" {
 		diags = setResourceField(data, "os_type", vm.OS().Type(), diags)
 	}
-	if _, ok := data.GetOk("vm_type"); ok {
-		diags = setResourceField(data, "vm_type", vm.VMType(), diags)
-	}
+	diags = setResourceField(data, "vm_type", vm.VMType(), diags)
 	if pp, ok := vm.PlacementPolicy(); ok {
 		diags = setResourceField(data, "placement_policy_host_ids", pp.HostIDs(), diags)
 		diags = setResourceField(data, "placement_policy_affinity", pp.Affinity(), diags)
 	}
+	diags = vmCPUResourceUpdate(vm, data, diags)
+	diags = vmMemoryResourceUpdate(vm, data, diags)
+	diags = vmInitializationResourceUpdate(vm, data, diags)
+	// The serial console and soundcard settings are only available on the full VM object.
+	if fullVM, ok := vm.(ovirtclient.VM); ok {
+		diags = setResourceField(data, "serial_console", fullVM.SerialConsole(), diags)
+		diags = setResourceField(data, "soundcard_enabled", fullVM.SoundcardEnabled(), diags)
+	}
+	if instanceTypeID := vm.InstanceTypeID(); instanceTypeID != nil {
+		diags = setResourceField(data, "instance_type_id", string(*instanceTypeID), diags)
+	} else {
+		diags = setResourceField(data, "instance_type_id", "", diags)
+	}
 	return diags
 }
 
+func vmCPUResourceUpdate(vm ovirtclient.VMData, data *schema.ResourceData, diags diag.Diagnostics) diag.Diagnostics {
+	cpu := vm.CPU()
+	if isNil(cpu) {
+		return diags
+	}
+	if mode := cpu.Mode(); mode != nil {
+		diags = setResourceField(data, "cpu_mode", string(*mode), diags)
+	}
+	// The mock backend returns a typed nil topology for VMs created without CPU parameters.
+	if topo := cpu.Topo(); !isNil(topo) {
+		diags = setResourceField(data, "cpu_cores", int(topo.Cores()), diags)
+		diags = setResourceField(data, "cpu_threads", int(topo.Threads()), diags)
+		diags = setResourceField(data, "cpu_sockets", int(topo.Sockets()), diags)
+	}
+	return diags
+}
+
+func vmMemoryResourceUpdate(vm ovirtclient.VMData, data *schema.ResourceData, diags diag.Diagnostics) diag.Diagnostics {
+	//nolint:gosec // G115: the engine doesn't report negative memory sizes
+	diags = setResourceField(data, "memory", formatSize(uint64(vm.Memory())), diags)
+	if memoryPolicy := vm.MemoryPolicy(); !isNil(memoryPolicy) {
+		if maxMemory := memoryPolicy.Max(); maxMemory != nil {
+			//nolint:gosec // G115: the engine doesn't report negative memory sizes
+			diags = setResourceField(data, "maximum_memory", formatSize(uint64(*maxMemory)), diags)
+		}
+		diags = setResourceField(data, "memory_ballooning", memoryPolicy.Ballooning(), diags)
+	}
+	if hugePages := vm.HugePages(); hugePages != nil {
+		diags = setResourceField(data, "huge_pages", int(*hugePages), diags)
+	} else {
+		diags = setResourceField(data, "huge_pages", 0, diags)
+	}
+	return diags
+}
+
+func vmInitializationResourceUpdate(
+	vm ovirtclient.VMData,
+	data *schema.ResourceData,
+	diags diag.Diagnostics,
+) diag.Diagnostics {
+	initialization := vm.Initialization()
+	if isNil(initialization) {
+		return diags
+	}
+	// The engine returns the custom script, which must not end up in the state if it was passed write-only. It is
+	// cleared rather than left as it is so a script stored before switching to the write-only variant is removed.
+	customScript := initialization.CustomScript()
+	if _, ok := data.GetOk("initialization_custom_script_wo_version"); ok {
+		customScript = ""
+	}
+	diags = setResourceField(data, "initialization_custom_script", customScript, diags)
+	diags = setResourceField(data, "initialization_hostname", initialization.HostName(), diags)
+	nicConfiguration := initialization.NicConfiguration()
+	if isNil(nicConfiguration) {
+		return setResourceField(data, "initialization_nic", []interface{}{}, diags)
+	}
+	nic := map[string]interface{}{
+		"name": nicConfiguration.Name(),
+		"ipv4": []interface{}{flattenIPConfiguration(nicConfiguration.IP())},
+	}
+	if ipv6 := nicConfiguration.IPV6(); ipv6 != nil {
+		nic["ipv6"] = []interface{}{flattenIPConfiguration(*ipv6)}
+	}
+	return setResourceField(data, "initialization_nic", []interface{}{nic}, diags)
+}
+
+func flattenIPConfiguration(ip ovirtclient.IP) map[string]interface{} {
+	return map[string]interface{}{
+		"address": ip.Address,
+		"netmask": ip.Netmask,
+		"gateway": ip.Gateway,
+	}
+}
+
 func (p *provider) vmDelete(ctx context.Context, data *schema.ResourceData, _ interface{}) diag.Diagnostics {
 	client := p.client.WithContext(ctx)
 	if err := client.RemoveVM(ovirtclient.VMID(data.Id())); err != nil {
@@ -1073,6 +1344,13 @@ func (p *provider) vmImport(ctx context.Context, data *schema.ResourceData, _ in
 	error,
 ) {
 	client := p.client.WithContext(ctx)
+	if isImportQuery(data.Id()) {
+		vmID, err := findVMForImport(client, data.Id())
+		if err != nil {
+			return nil, fmt.Errorf("failed to import VM (%w)", err)
+		}
+		data.SetId(string(vmID))
+	}
 	vm, err := client.GetVM(ovirtclient.VMID(data.Id()))
 	if err != nil {
 		return nil, fmt.Errorf("failed to import VM %s (%w)", data.Id(), err)
@@ -43,8 +43,11 @@ func (p *provider) vmAffinityGroupResource() *schema.Resource {
 		CreateContext: p.vmAffinityGroupCreate,
 		ReadContext:   p.vmAffinityGroupRead,
 		DeleteContext: p.vmAffinityGroupDelete,
-		Schema:        vmAffinityGroupSchema,
-		Description:   "The ovirt_vm_affinity_group resource assigns VMs to affinity groups in oVirt.",
+		Importer: &schema.ResourceImporter{
+			StateContext: p.vmAffinityGroupImport,
+		},
+		Schema:      vmAffinityGroupSchema,
+		Description: "The ovirt_vm_affinity_group resource assigns VMs to affinity groups in oVirt.",
 	}
 }
 
@@ -109,3 +112,44 @@ func (p *provider) vmAffinityGroupDelete(ctx context.Context, data *schema.Resou
 
 	return nil
 }
+
+func (p *provider) vmAffinityGroupImport(ctx context.Context, data *schema.ResourceData, _ interface{}) (
+	[]*schema.ResourceData,
+	error,
+) {
+	importID := data.Id()
+	parts, err := splitImportID(importID, "/", "ClusterID/AffinityGroupID/VMID")
+	if err != nil {
+		return nil, err
+	}
+	clusterID := parts[0]
+	affinityGroupID := parts[1]
+	VMID := parts[2]
+
+	client := p.client.WithContext(ctx)
+	affinityGroup, err := client.GetAffinityGroup(ovirtclient.ClusterID(clusterID), ovirtclient.AffinityGroupID(affinityGroupID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to import VM affinity group assignment %s (%w)", importID, err)
+	}
+	found := false
+	for _, affinityGroupVMID := range affinityGroup.VMIDs() {
+		if string(affinityGroupVMID) == VMID {
+			found = true
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("VM %s is not in affinity group %s in cluster %s", VMID, affinityGroupID, clusterID)
+	}
+
+	data.SetId(VMID)
+	diags := diag.Diagnostics{}
+	diags = setResourceField(data, "cluster_id", clusterID, diags)
+	diags = setResourceField(data, "vm_id", VMID, diags)
+	diags = setResourceField(data, "affinity_group_id", affinityGroupID, diags)
+	if err := diagsToError(diags); err != nil {
+		return nil, fmt.Errorf("failed to import VM affinity group assignment %s (%w)", importID, err)
+	}
+	return []*schema.ResourceData{
+		data,
+	}, nil
+}
@@ -77,6 +77,20 @@ func TestVMAffinityGroupResource(t *testing.T) {
 						},
 					),
 				},
+				{
+					ImportState:       true,
+					ImportStateVerify: true,
+					ResourceName:      "ovirt_vm_affinity_group.vm1_to_ag1",
+					ImportStateIdFunc: func(state *terraform.State) (string, error) {
+						attributes := state.RootModule().Resources["ovirt_vm_affinity_group.vm1_to_ag1"].Primary.Attributes
+						return fmt.Sprintf(
+							"%s/%s/%s",
+							attributes["cluster_id"],
+							attributes["affinity_group_id"],
+							attributes["vm_id"],
+						), nil
+					},
+				},
 			},
 		},
 	)
@@ -18,25 +18,37 @@ var vmDisksResizeSchema = map[string]*schema.Schema{
 		ValidateDiagFunc: validateUUID,
 	},
 	"size": {
-		Type:             schema.TypeInt,
+		Type:             schema.TypeString,
 		Required:         true,
 		ForceNew:         true,
-		Description:      "Disk size in bytes to set all disks to.",
-		ValidateDiagFunc: validateDiskSize,
+		Description:      sizeDescription("Disk size to set all disks to.", diskAlignment),
+		ValidateDiagFunc: validateSize(diskAlignment),
+		StateFunc:        normalizeSize,
 	},
 }
 
 func (p *provider) vmDisksResizeResource() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		CreateContext: p.vmDisksResizeCreate,
 		ReadContext:   p.vmDisksResizeRead,
 		DeleteContext: p.vmDisksResizeDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: p.vmDisksResizeImport,
+		},
 		Schema:        vmDisksResizeSchema,
+		SchemaVersion: 1,
 		Description: `The ovirt_vm_disks_resize resource resizes all disks in an oVirt VM to the specified size. 
 		
 ~> Only use this resource with disks created from templates. Otherwise, two terraform resources will handle the same disk resource.  
 		`,
 	}
+	resource.StateUpgraders = []schema.StateUpgrader{sizeStateUpgrader(resource, "size")}
+	return resource
 }
 
 func (p *provider) vmDisksResizeCreate(
@@ -50,17 +62,16 @@ func (p *provider) vmDisksResizeCreate(
 
 func (p *provider) vmDisksResizeRead(ctx context.Context, data *schema.ResourceData, _ interface{}) diag.Diagnostics {
 	client := p.client.WithContext(ctx)
-	if data.Get("size").(int) < 0 {
+	desiredSize, err := sizeValue(data.Get("size"))
+	if err != nil {
 		return diag.Diagnostics{
 			diag.Diagnostic{
 				Severity: diag.Error,
 				Summary:  "Invalid disk size.",
-				Detail:   "Disk size must be a non-negative integer.",
+				Detail:   err.Error(),
 			},
 		}
 	}
-	//nolint:gosec // G115: size is validated to be > 0 above
-	desiredSize := uint64(data.Get("size").(int))
 	size := desiredSize
 
 	vmID := ovirtclient.VMID(data.Get("vm_id").(string))
@@ -70,7 +81,7 @@ func (p *provider) vmDisksResizeRead(ctx context.Context, data *schema.ResourceD
 		return errorToDiags(fmt.Sprintf("list disk attachments of VM %s", vmID), err)
 	}
 	for _, diskAttachment := range diskAttachments {
-		disk, err := diskAttachment.Disk()
+		disk, err := client.GetDisk(diskAttachment.DiskID())
 		if err != nil {
 			return errorToDiags(fmt.Sprintf("get disk %s", diskAttachment.DiskID()), err)
 		}
@@ -82,11 +93,30 @@ func (p *provider) vmDisksResizeRead(ctx context.Context, data *schema.ResourceD
 
 	data.SetId(string(vmID))
 	diags := diag.Diagnostics{}
-	diags = setResourceField(data, "size", size, diags)
+	diags = setResourceField(data, "size", formatSize(size), diags)
 
 	return diags
 }
 
+// vmDisksResizeImport imports the disk sizes of a VM by the VM ID. If the disks of the VM have different sizes, the
+// size of one of them is imported so that the next apply resizes the others.
+func (p *provider) vmDisksResizeImport(
+	ctx context.Context,
+	data *schema.ResourceData,
+	_ interface{},
+) ([]*schema.ResourceData, error) {
+	vmID := data.Id()
+	if err := data.Set("vm_id", vmID); err != nil {
+		return nil, fmt.Errorf("failed to set vm_id to %s (%w)", vmID, err)
+	}
+	if err := diagsToError(p.vmDisksResizeRead(ctx, data, nil)); err != nil {
+		return nil, fmt.Errorf("failed to import disk sizes of VM %s (%w)", vmID, err)
+	}
+	return []*schema.ResourceData{
+		data,
+	}, nil
+}
+
 func (p *provider) vmDisksResizeDelete(_ context.Context, data *schema.ResourceData, _ interface{}) diag.Diagnostics {
 	data.SetId("")
 	return nil
@@ -94,17 +124,16 @@ func (p *provider) vmDisksResizeDelete(_ context.Context, data *schema.ResourceD
 
 func resizeAllDisks(client ovirtclient.Client, data *schema.ResourceData) diag.Diagnostics {
 	vmID := ovirtclient.VMID(data.Get("vm_id").(string))
-	if data.Get("size").(int) < 0 {
+	desiredSize, err := sizeValue(data.Get("size"))
+	if err != nil {
 		return diag.Diagnostics{
 			diag.Diagnostic{
 				Severity: diag.Error,
 				Summary:  "Invalid disk size.",
-				Detail:   "Disk size must be a non-negative integer.",
+				Detail:   err.Error(),
 			},
 		}
 	}
-	//nolint:gosec // G115: size is validated to be > 0 above
-	desiredSize := uint64(data.Get("size").(int))
 
 	diskAttachments, err := client.ListDiskAttachments(vmID)
 	if err != nil {
@@ -112,7 +141,7 @@ func resizeAllDisks(client ovirtclient.Client, data *schema.ResourceData) diag.D
 	}
 	var diags diag.Diagnostics
 	for _, diskAttachment := range diskAttachments {
-		disk, err := diskAttachment.Disk()
+		disk, err := client.GetDisk(diskAttachment.DiskID())
 		if err != nil {
 			return errorToDiags(fmt.Sprintf("get disk %s", diskAttachment.DiskID()), err)
 		}
@@ -135,14 +164,7 @@ func resizeAllDisks(client ovirtclient.Client, data *schema.ResourceData) diag.D
 			Severity: diag.Error,
 			Summary:  fmt.Sprintf("Failed to update disk %s size.", disk.ID()),
 		}
-		diskUpdate, err := client.StartUpdateDisk(disk.ID(), params)
-		if err != nil {
-			updateFailedDiag.Detail = err.Error()
-			diags = append(diags, updateFailedDiag)
-			continue
-		}
-		_, err = diskUpdate.Wait()
-		if err != nil {
+		if _, err := client.UpdateDisk(disk.ID(), params); err != nil {
 			updateFailedDiag.Detail = err.Error()
 			diags = append(diags, updateFailedDiag)
 			continue
@@ -151,7 +173,7 @@ func resizeAllDisks(client ovirtclient.Client, data *schema.ResourceData) diag.D
 
 	data.SetId(string(vmID))
 	if !diags.HasError() {
-		diags = setResourceField(data, "size", desiredSize, diags)
+		diags = setResourceField(data, "size", formatSize(desiredSize), diags)
 	}
 	return diags
 }
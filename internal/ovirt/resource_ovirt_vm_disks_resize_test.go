@@ -94,6 +94,12 @@ func TestVMDisksResize(t *testing.T) {
 					desiredDiskSize,
 				)
 
+				var importStateVerifyIgnore []string
+				if testCase.diskCount == 0 {
+					// Without disks there is no size to import.
+					importStateVerifyIgnore = []string{"size"}
+				}
+
 				resource.UnitTest(
 					t, resource.TestCase{
 						ProviderFactories: p.getProviderFactories(),
@@ -116,6 +122,13 @@ func TestVMDisksResize(t *testing.T) {
 									return nil
 								},
 							},
+							{
+								Config:                  config,
+								ImportState:             true,
+								ImportStateVerify:       true,
+								ResourceName:            "ovirt_vm_disks_resize.resized",
+								ImportStateVerifyIgnore: importStateVerifyIgnore,
+							},
 							{
 								Config:  config,
 								Destroy: true,
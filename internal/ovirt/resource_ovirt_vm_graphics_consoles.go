@@ -48,8 +48,11 @@ func (p *provider) vmGraphicsConsolesResource() *schema.Resource {
 		CreateContext: p.vmGraphicsConsolesCreate,
 		ReadContext:   p.vmGraphicsConsolesRead,
 		DeleteContext: p.vmGraphicsConsolesDelete,
-		Schema:        vmGraphicsConsolesSchema,
-		Description:   "The ovirt_vm_graphics_consoles controls all the graphic consoles of a VM.",
+		Importer: &schema.ResourceImporter{
+			StateContext: p.vmGraphicsConsolesImport,
+		},
+		Schema:      vmGraphicsConsolesSchema,
+		Description: "The ovirt_vm_graphics_consoles controls all the graphic consoles of a VM.",
 	}
 }
 
@@ -78,7 +81,7 @@ func (p *provider) vmGraphicsConsolesCreate(
 	}
 	var diags diag.Diagnostics
 	for _, console := range cons {
-		if err := console.Remove(); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		if err := client.RemoveVMGraphicsConsole(console.VMID(), console.ID()); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
 			diags = append(diags, errorToDiag(fmt.Sprintf("remove graphics console %s", console.ID()), err))
 		}
 	}
@@ -128,3 +131,20 @@ func (p *provider) vmGraphicsConsolesDelete(
 	data.SetId("")
 	return nil
 }
+
+func (p *provider) vmGraphicsConsolesImport(
+	ctx context.Context,
+	data *schema.ResourceData,
+	_ interface{},
+) ([]*schema.ResourceData, error) {
+	vmID := data.Id()
+	if err := data.Set("vm_id", vmID); err != nil {
+		return nil, fmt.Errorf("failed to set vm_id to %s (%w)", vmID, err)
+	}
+	if err := diagsToError(p.vmGraphicsConsolesRead(ctx, data, nil)); err != nil {
+		return nil, fmt.Errorf("failed to import graphics consoles of VM %s (%w)", vmID, err)
+	}
+	return []*schema.ResourceData{
+		data,
+	}, nil
+}
@@ -54,6 +54,12 @@ resource "ovirt_vm_graphics_consoles" "foo" {
 						},
 					),
 				},
+				{
+					Config:            config,
+					ImportState:       true,
+					ImportStateVerify: true,
+					ResourceName:      "ovirt_vm_graphics_consoles.foo",
+				},
 				{
 					Config:  config,
 					Destroy: true,
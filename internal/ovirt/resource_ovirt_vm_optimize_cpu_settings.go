@@ -2,6 +2,7 @@ package ovirt
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,11 +29,32 @@ func (p *provider) vmOptimizeCPUSettingsResource() *schema.Resource {
 		CreateContext: p.vmOptimizeCPUSettingsCreate,
 		ReadContext:   p.vmOptimizeCPUSettingsRead,
 		DeleteContext: p.vmOptimizeCPUSettingsDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: p.vmOptimizeCPUSettingsImport,
+		},
+		CustomizeDiff: p.vmOptimizeCPUSettingsCustomizeDiff,
 		Schema:        vmOptimizeCPUSettingsSchema,
-		Description:   "The ovirt_vm_optimize_cpu_settings sets the CPU settings to automatically optimized for the specified VM.",
+		Description:   "The ovirt_vm_optimize_cpu_settings sets the CPU settings to automatically optimized for the specified VM. The engine does not report whether the CPU settings of a VM are auto-optimized, so importing this resource only checks that the VM exists and does not detect VMs whose CPU settings are not optimized.",
 	}
 }
 
+// vmOptimizeCPUSettingsCustomizeDiff rejects the resource at plan time if the engine doesn't support auto-pinning.
+func (p *provider) vmOptimizeCPUSettingsCustomizeDiff(
+	_ context.Context,
+	diff *schema.ResourceDiff,
+	_ interface{},
+) error {
+	if diff.Id() != "" {
+		return nil
+	}
+	return p.engine.requireFeature(ovirtclient.FeatureAutoPinning, "ovirt_vm_optimize_cpu_settings", "vm_id")
+}
+
 func (p *provider) vmOptimizeCPUSettingsCreate(
 	ctx context.Context,
 	data *schema.ResourceData,
@@ -56,6 +78,25 @@ func (p *provider) vmOptimizeCPUSettingsRead(
 	return nil
 }
 
+// vmOptimizeCPUSettingsImport imports the CPU optimization of a VM by the VM ID. The client library doesn't expose
+// whether the CPU settings of a VM are auto-optimized, so only the existence of the VM is checked.
+func (p *provider) vmOptimizeCPUSettingsImport(
+	ctx context.Context,
+	data *schema.ResourceData,
+	_ interface{},
+) ([]*schema.ResourceData, error) {
+	vmID := data.Id()
+	if _, err := p.client.WithContext(ctx).GetVM(ovirtclient.VMID(vmID)); err != nil {
+		return nil, fmt.Errorf("failed to import CPU settings of VM %s (%w)", vmID, err)
+	}
+	if err := data.Set("vm_id", vmID); err != nil {
+		return nil, fmt.Errorf("failed to set vm_id to %s (%w)", vmID, err)
+	}
+	return []*schema.ResourceData{
+		data,
+	}, nil
+}
+
 func (p *provider) vmOptimizeCPUSettingsDelete(
 	ctx context.Context,
 	data *schema.ResourceData,
@@ -48,6 +48,12 @@ resource "ovirt_vm_optimize_cpu_settings" "foo" {
 						),
 					),
 				},
+				{
+					Config:            config,
+					ImportState:       true,
+					ImportStateVerify: true,
+					ResourceName:      "ovirt_vm_optimize_cpu_settings.foo",
+				},
 				{
 					Config:  config,
 					Destroy: true,
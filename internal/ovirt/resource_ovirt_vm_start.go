@@ -3,8 +3,9 @@ package ovirt
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
-	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
@@ -20,6 +21,24 @@ const (
 	VMStopBehaviorShutdown VMStopBehavior = "shutdown"
 )
 
+func vmStartStatusValues() []string {
+	return []string{
+		string(ovirtclient.VMStatusUp),
+		string(ovirtclient.VMStatusDown),
+	}
+}
+
+// vmStartTransitions maps the statuses a VM passes through while it changes state to the status it settles in.
+var vmStartTransitions = map[ovirtclient.VMStatus]ovirtclient.VMStatus{
+	ovirtclient.VMStatusWaitForLaunch:  ovirtclient.VMStatusUp,
+	ovirtclient.VMStatusPoweringUp:     ovirtclient.VMStatusUp,
+	ovirtclient.VMStatusRebooting:      ovirtclient.VMStatusUp,
+	ovirtclient.VMStatusMigrating:      ovirtclient.VMStatusUp,
+	ovirtclient.VMStatusRestoringState: ovirtclient.VMStatusUp,
+	ovirtclient.VMStatusPoweringDown:   ovirtclient.VMStatusDown,
+	ovirtclient.VMStatusSavingState:    ovirtclient.VMStatusDown,
+}
+
 func vmStopBehaviorValues() []string {
 	return []string{
 		string(VMStopBehaviorStop),
@@ -41,24 +60,15 @@ var vmStartSchema = map[string]*schema.Schema{
 		ValidateDiagFunc: validateUUID,
 	},
 	"status": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Default:     "up",
-		Description: "Desired status of the VM. The only valid value is \"up\".",
-		ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
-			if i == "up" {
-				return nil
-			}
-			return diag.Diagnostics{
-				diag.Diagnostic{
-					Severity:      diag.Error,
-					Summary:       "Invalid value.",
-					Detail:        "The value must be \"up\".",
-					AttributePath: path,
-				},
-			}
-		},
-		ForceNew: true,
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  ovirtclient.VMStatusUp,
+		Description: fmt.Sprintf(
+			"Desired status of the VM. One of: `%s`. Changing this value starts or stops the VM in place. "+
+				"While the VM is changing state, for example powering up or migrating, the previous status is kept.",
+			strings.Join(vmStartStatusValues(), "`, `"),
+		),
+		ValidateDiagFunc: validateEnum(vmStartStatusValues()),
 	},
 	"stop_behavior": {
 		Type:             schema.TypeString,
@@ -75,6 +85,32 @@ var vmStartSchema = map[string]*schema.Schema{
 		Description: "Force stop/shutdown even if a backup is in progress.",
 		ForceNew:    false,
 	},
+	"shutdown_timeout": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Description: "Maximum time to wait for an ACPI shutdown to complete when `stop_behavior` is \"shutdown\", " +
+			"for example `5m`. Defaults to `stop_timeout`.",
+		ValidateDiagFunc: validateDuration,
+	},
+	"escalate_to_stop": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+		Description: "Power off the VM if the ACPI shutdown does not complete within `shutdown_timeout`. " +
+			"A warning is emitted when this happens.",
+	},
+	"start_timeout": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Maximum time to wait for the VM to come up, for example `10m`. Limited by the timeouts block of the resource.",
+		ValidateDiagFunc: validateDuration,
+	},
+	"stop_timeout": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Maximum time to wait for the VM to go down, for example `10m`. Limited by the timeouts block of the resource.",
+		ValidateDiagFunc: validateDuration,
+	},
 }
 
 func (p *provider) vmStartResource() *schema.Resource {
@@ -83,11 +119,17 @@ func (p *provider) vmStartResource() *schema.Resource {
 		ReadContext:   p.vmStartRead,
 		UpdateContext: p.vmStartUpdate,
 		DeleteContext: p.vmStartDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: p.vmStartImport,
 		},
 		Schema:      vmStartSchema,
-		Description: "The ovirt_vm_start resource starts a VM in oVirt when created and stops the VM when destroyed. If additional resources should be created before the VM is started, please use the `depends_on` clause.",
+		Description: "The ovirt_vm_start resource starts a VM in oVirt when created and stops the VM when destroyed. The `status` field can be changed to start or stop the VM without destroying the resource. If additional resources should be created before the VM is started, please use the `depends_on` clause.",
 	}
 }
 
@@ -98,18 +140,15 @@ func (p *provider) vmStartCreate(
 ) diag.Diagnostics {
 	client := p.client.WithContext(ctx)
 	id := data.Get("vm_id").(string)
-	if err := client.StartVM(ovirtclient.VMID(id)); err != nil {
-		return errorToDiags("start VM", err)
-	}
-	desiredStatus := data.Get("status").(string)
-	vm, err := client.WaitForVMStatus(
-		ovirtclient.VMID(id),
-		ovirtclient.VMStatus(desiredStatus),
-	)
+	vm, err := client.GetVM(ovirtclient.VMID(id))
 	if err != nil {
-		return errorToDiags("wait for VM start", err)
+		return errorToDiags("get VM status", err)
 	}
-	return vmStartResourceUpdate(vm, data)
+	vm, diags := vmStartConverge(ctx, client, vm, data)
+	if diags.HasError() {
+		return diags
+	}
+	return append(diags, vmStartResourceUpdate(vm, data)...)
 }
 
 func (p *provider) vmStartRead(
@@ -131,13 +170,23 @@ func (p *provider) vmStartRead(
 }
 
 func (p *provider) vmStartUpdate(
-	_ context.Context,
+	ctx context.Context,
 	data *schema.ResourceData,
 	_ interface{},
 ) diag.Diagnostics {
-	_ = data.Set("force_stop", data.Get("force_stop").(bool))
-	_ = data.Set("stop_behavior", data.Get("stop_behavior").(string))
-	return nil
+	client := p.client.WithContext(ctx)
+	vm, err := client.GetVM(ovirtclient.VMID(data.Id()))
+	if err != nil {
+		if isNotFound(err) {
+			data.SetId("")
+		}
+		return errorToDiags("get VM status", err)
+	}
+	vm, diags := vmStartConverge(ctx, client, vm, data)
+	if diags.HasError() {
+		return diags
+	}
+	return append(diags, vmStartResourceUpdate(vm, data)...)
 }
 
 func (p *provider) vmStartDelete(
@@ -146,27 +195,141 @@ func (p *provider) vmStartDelete(
 	_ interface{},
 ) diag.Diagnostics {
 	client := p.client.WithContext(ctx)
+	var diags diag.Diagnostics
+	if data.Get("status").(string) != string(ovirtclient.VMStatusDown) {
+		if _, diags = vmStartStop(ctx, client, data); diags.HasError() {
+			return diags
+		}
+	}
+	data.SetId("")
+	_ = data.Set("status", "")
+	return diags
+}
+
+// vmStartConverge starts or stops the VM until it reaches the status configured in data.
+func vmStartConverge(
+	ctx context.Context,
+	client ovirtclient.Client,
+	vm ovirtclient.VM,
+	data *schema.ResourceData,
+) (ovirtclient.VM, diag.Diagnostics) {
+	desiredStatus := ovirtclient.VMStatus(data.Get("status").(string))
+	if vm.Status() == desiredStatus {
+		return vm, nil
+	}
+	// A VM that is already on its way to the desired status is only waited for, starting or stopping it again
+	// would fail while it changes state.
+	if vmStartTransitions[vm.Status()] == desiredStatus {
+		timeout := data.Get("start_timeout").(string)
+		if desiredStatus == ovirtclient.VMStatusDown {
+			timeout = data.Get("stop_timeout").(string)
+		}
+		vm, err := waitForVMStatus(ctx, client, vm.ID(), desiredStatus, timeout)
+		if err != nil {
+			return nil, errorToDiags(fmt.Sprintf("wait for VM to become %s", desiredStatus), err)
+		}
+		return vm, nil
+	}
+	if desiredStatus == ovirtclient.VMStatusDown {
+		return vmStartStop(ctx, client, data)
+	}
+	if err := client.StartVM(vm.ID()); err != nil {
+		return nil, errorToDiags("start VM", err)
+	}
+	vm, err := waitForVMStatus(ctx, client, vm.ID(), ovirtclient.VMStatusUp, data.Get("start_timeout").(string))
+	if err != nil {
+		return nil, errorToDiags("wait for VM start", err)
+	}
+	return vm, nil
+}
+
+// vmStartStop stops the VM using the configured stop_behavior and waits for it to go down. If the ACPI shutdown does
+// not complete within shutdown_timeout and escalate_to_stop is set the VM is powered off instead.
+func vmStartStop(
+	ctx context.Context,
+	client ovirtclient.Client,
+	data *schema.ResourceData,
+) (ovirtclient.VM, diag.Diagnostics) {
+	id := ovirtclient.VMID(data.Get("vm_id").(string))
 	stopBehavior := data.Get("stop_behavior").(string)
 	force := data.Get("force_stop").(bool)
-	var err error
+	stopTimeout := data.Get("stop_timeout").(string)
 	if stopBehavior == string(VMStopBehaviorStop) {
-		err = client.StopVM(ovirtclient.VMID(data.Id()), force)
-	} else {
-		err = client.ShutdownVM(ovirtclient.VMID(data.Id()), force)
+		if err := client.StopVM(id, force); err != nil {
+			return nil, errorToDiags("stop VM", err)
+		}
+		vm, err := waitForVMStatus(ctx, client, id, ovirtclient.VMStatusDown, stopTimeout)
+		if err != nil {
+			return nil, errorToDiags("wait for VM to stop", err)
+		}
+		return vm, nil
+	}
+
+	if err := client.ShutdownVM(id, force); err != nil {
+		return nil, errorToDiags("shutdown VM", err)
+	}
+	shutdownTimeout := data.Get("shutdown_timeout").(string)
+	if shutdownTimeout == "" {
+		shutdownTimeout = stopTimeout
 	}
+	vm, err := waitForVMStatus(ctx, client, id, ovirtclient.VMStatusDown, shutdownTimeout)
+	if err == nil {
+		return vm, nil
+	}
+	if !data.Get("escalate_to_stop").(bool) || !ovirtclient.HasErrorCode(err, ovirtclient.ETimeout) {
+		return nil, errorToDiags("wait for VM to shut down", err)
+	}
+
+	diags := diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "VM shutdown timed out, powering off",
+			Detail: fmt.Sprintf(
+				"VM %s did not shut down within %s, possibly because the guest does not handle ACPI shutdown "+
+					"requests. The VM was powered off instead because escalate_to_stop is set. (%v)",
+				id,
+				shutdownTimeout,
+				err,
+			),
+		},
+	}
+	if err := client.StopVM(id, force); err != nil {
+		return nil, append(diags, errorToDiag("stop VM", err))
+	}
+	vm, err = waitForVMStatus(ctx, client, id, ovirtclient.VMStatusDown, stopTimeout)
 	if err != nil {
-		return errorToDiags("shutdown VM status", err)
+		return nil, append(diags, errorToDiag("wait for VM to stop", err))
 	}
-	_, err = client.WaitForVMStatus(
-		ovirtclient.VMID(data.Id()),
-		ovirtclient.VMStatusDown,
-	)
+	return vm, diags
+}
+
+// waitForVMStatus waits for the VM to reach the specified status. If timeout is not empty the wait is aborted after
+// the specified duration, otherwise the go-ovirt-client default timeouts apply.
+func waitForVMStatus(
+	ctx context.Context,
+	client ovirtclient.Client,
+	id ovirtclient.VMID,
+	status ovirtclient.VMStatus,
+	timeout string,
+) (ovirtclient.VM, error) {
+	if timeout == "" {
+		return client.WaitForVMStatus(id, status)
+	}
+	duration, err := time.ParseDuration(timeout)
 	if err != nil {
-		return errorToDiags("wait for VM to stop", err)
+		return nil, err
 	}
-	data.SetId("")
-	_ = data.Set("status", "")
-	return nil
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+	// go-ovirt-client matches the expired wait channel by index, so the strategies that wait must come first.
+	return client.WithContext(ctx).WaitForVMStatus(
+		id,
+		status,
+		ovirtclient.ExponentialBackoff(2),
+		ovirtclient.ContextStrategy(ctx),
+		ovirtclient.Timeout(duration),
+		ovirtclient.ReconnectStrategy(client),
+	)
 }
 
 func (p *provider) vmStartImport(ctx context.Context, data *schema.ResourceData, _ interface{}) (
@@ -191,6 +354,26 @@ func vmStartResourceUpdate(vm ovirtclient.VM, data *schema.ResourceData) diag.Di
 	diags := diag.Diagnostics{}
 	data.SetId(string(vm.ID()))
 	diags = setResourceField(data, "vm_id", vm.ID(), diags)
-	diags = setResourceField(data, "status", vm.Status(), diags)
+	diags = setResourceField(data, "status", vmStartStatus(vm.Status(), data.Get("status").(string)), diags)
 	return diags
 }
+
+// vmStartStatus returns the value of the status field for the current status of the VM, which is always up or down.
+// In any other status, for example while the VM changes state or is paused, the status it had been converged to is
+// kept, so that a refresh doesn't produce a diff and the next apply doesn't start or stop a VM that is still busy.
+// Without a previous status, for example on import, the status the VM is heading for is used.
+func vmStartStatus(status ovirtclient.VMStatus, previousStatus string) ovirtclient.VMStatus {
+	if status == ovirtclient.VMStatusUp || status == ovirtclient.VMStatusDown {
+		return status
+	}
+	if previousStatus == string(ovirtclient.VMStatusUp) || previousStatus == string(ovirtclient.VMStatusDown) {
+		return ovirtclient.VMStatus(previousStatus)
+	}
+	if settledStatus, ok := vmStartTransitions[status]; ok {
+		return settledStatus
+	}
+	if status == ovirtclient.VMStatusSuspended {
+		return ovirtclient.VMStatusDown
+	}
+	return ovirtclient.VMStatusUp
+}
@@ -1,11 +1,17 @@
 package ovirt
 
 import (
+	"context"
 	"fmt"
 	"regexp"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
 )
 
 func TestVMStartResource(t *testing.T) {
@@ -54,3 +60,206 @@ resource "ovirt_vm_start" "foo" {
 		},
 	})
 }
+
+func TestVMStartResourceStatusChange(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(newTestLogger(t))
+	clusterID := p.getTestHelper().GetClusterID()
+	templateID := p.getTestHelper().GetBlankTemplateID()
+	configTemplate := `
+provider "ovirt" {
+	mock = true
+}
+
+resource "ovirt_vm" "foo" {
+	cluster_id = "%s"
+	template_id = "%s"
+	name = "test"
+}
+
+resource "ovirt_vm_start" "foo" {
+	vm_id         = ovirt_vm.foo.id
+	status        = "%s"
+	stop_behavior = "stop"
+	stop_timeout  = "1m"
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: p.getProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configTemplate, clusterID, templateID, "up"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovirt_vm_start.foo", "status", "up"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(configTemplate, clusterID, templateID, "down"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovirt_vm_start.foo", "status", "down"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(configTemplate, clusterID, templateID, "up"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovirt_vm_start.foo", "status", "up"),
+				),
+			},
+		},
+	})
+}
+
+func TestVMStartResourceShutdownEscalation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	p := newProvider(newTestLogger(t)).(*provider)
+	providerData := schema.TestResourceDataRaw(t, providerSchema, map[string]interface{}{"mock": true})
+	if _, diags := p.configureProvider(ctx, providerData); diags.HasError() {
+		t.Fatalf("failed to configure provider (%v)", diags)
+	}
+	// The guest ignores the ACPI shutdown, so only powering it off stops the VM.
+	lock := &sync.Mutex{}
+	stopCalls := 0
+	client := wrapClient(
+		p.client,
+		func(
+			client ovirtclient.Client,
+			method string,
+			retries []ovirtclient.RetryStrategy,
+			next clientCall,
+		) error {
+			switch method {
+			case "ShutdownVM":
+				return nil
+			case "StopVM":
+				lock.Lock()
+				stopCalls++
+				lock.Unlock()
+			}
+			return next(retries)
+		},
+	).WithContext(ctx)
+	helper := p.getTestHelper()
+	vm, err := client.CreateVM(helper.GetClusterID(), helper.GetBlankTemplateID(), "test", nil)
+	if err != nil {
+		t.Fatalf("failed to create test VM (%v)", err)
+	}
+	if err := client.StartVM(vm.ID()); err != nil {
+		t.Fatalf("failed to start test VM (%v)", err)
+	}
+
+	data := schema.TestResourceDataRaw(
+		t, vmStartSchema, map[string]interface{}{
+			"vm_id":            string(vm.ID()),
+			"stop_behavior":    string(VMStopBehaviorShutdown),
+			"shutdown_timeout": "1s",
+			"escalate_to_stop": true,
+		},
+	)
+	vm, diags := vmStartStop(ctx, client, data)
+	if diags.HasError() {
+		t.Fatalf("failed to stop VM (%v)", diags)
+	}
+	if vm.Status() != ovirtclient.VMStatusDown {
+		t.Fatalf("incorrect VM status after escalation: %s", vm.Status())
+	}
+	lock.Lock()
+	if stopCalls != 1 {
+		t.Fatalf("the VM was powered off %d times instead of once", stopCalls)
+	}
+	lock.Unlock()
+	if len(diags) != 1 || diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Summary, "powering off") {
+		t.Fatalf("no warning about the escalation was emitted (%v)", diags)
+	}
+}
+
+func TestVMStartResourceCreateTimeout(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(newTestLogger(t))
+	clusterID := p.getTestHelper().GetClusterID()
+	templateID := p.getTestHelper().GetBlankTemplateID()
+	config := fmt.Sprintf(
+		`
+provider "ovirt" {
+	mock = true
+}
+
+resource "ovirt_vm" "foo" {
+	cluster_id = "%s"
+	template_id = "%s"
+	name = "test"
+}
+
+resource "ovirt_vm_start" "foo" {
+	vm_id = ovirt_vm.foo.id
+
+	timeouts {
+		create = "1s"
+	}
+}
+`,
+		clusterID,
+		templateID,
+	)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: p.getProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("timeout"),
+			},
+		},
+	})
+}
+
+func TestVMStartResourceReadDuringTransition(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	p := newProvider(newTestLogger(t)).(*provider)
+	providerData := schema.TestResourceDataRaw(t, providerSchema, map[string]interface{}{"mock": true})
+	if _, diags := p.configureProvider(ctx, providerData); diags.HasError() {
+		t.Fatalf("failed to configure provider (%v)", diags)
+	}
+	client := p.client.WithContext(ctx)
+	helper := p.getTestHelper()
+	vm, err := client.CreateVM(helper.GetClusterID(), helper.GetBlankTemplateID(), "test", nil)
+	if err != nil {
+		t.Fatalf("failed to create test VM (%v)", err)
+	}
+	// The mock engine keeps the VM in wait_for_launch for a while after starting it.
+	if err := client.StartVM(vm.ID()); err != nil {
+		t.Fatalf("failed to start test VM (%v)", err)
+	}
+
+	for previousStatus, expectedStatus := range map[string]string{
+		"up":   "up",
+		"down": "down",
+		"":     "up",
+	} {
+		data := p.vmStartResource().Data(nil)
+		data.SetId(string(vm.ID()))
+		if err := data.Set("vm_id", string(vm.ID())); err != nil {
+			t.Fatalf("failed to set vm_id (%v)", err)
+		}
+		if err := data.Set("status", previousStatus); err != nil {
+			t.Fatalf("failed to set status (%v)", err)
+		}
+		if diags := p.vmStartRead(ctx, data, nil); diags.HasError() {
+			t.Fatalf("failed to read VM status (%v)", diags)
+		}
+		if status := data.Get("status"); status != expectedStatus {
+			t.Fatalf(
+				"incorrect status after refresh during start with previous status %q: %s (expected %s)",
+				previousStatus,
+				status,
+				expectedStatus,
+			)
+		}
+	}
+}
@@ -37,8 +37,11 @@ func (p *provider) vmTagResource() *schema.Resource {
 		CreateContext: p.vmTagCreate,
 		ReadContext:   p.vmTagRead,
 		DeleteContext: p.vmTagDelete,
-		Schema:        vmTagSchema,
-		Description:   "The ovirt_vm_tag resource attaches a tag to a virtual machine.",
+		Importer: &schema.ResourceImporter{
+			StateContext: p.vmTagImport,
+		},
+		Schema:      vmTagSchema,
+		Description: "The ovirt_vm_tag resource attaches a tag to a virtual machine.",
 	}
 }
 
@@ -97,3 +100,41 @@ func (p *provider) vmTagDelete(ctx context.Context, data *schema.ResourceData, i
 	}
 	return nil
 }
+
+func (p *provider) vmTagImport(ctx context.Context, data *schema.ResourceData, _ interface{}) (
+	[]*schema.ResourceData,
+	error,
+) {
+	importID := data.Id()
+	parts, err := splitImportID(importID, "_", "TagID_VMID")
+	if err != nil {
+		return nil, err
+	}
+	tagID := parts[0]
+	vmID := parts[1]
+
+	client := p.client.WithContext(ctx)
+	tags, err := client.ListVMTags(ovirtclient.VMID(vmID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to import tag attachment %s (%w)", importID, err)
+	}
+	found := false
+	for _, tag := range tags {
+		if tag.ID() == ovirtclient.TagID(tagID) {
+			found = true
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("tag %s is not attached to VM %s", tagID, vmID)
+	}
+
+	diags := diag.Diagnostics{}
+	diags = setResourceField(data, "tag_id", tagID, diags)
+	diags = setResourceField(data, "vm_id", vmID, diags)
+	if err := diagsToError(diags); err != nil {
+		return nil, fmt.Errorf("failed to import tag attachment %s (%w)", importID, err)
+	}
+	return []*schema.ResourceData{
+		data,
+	}, nil
+}
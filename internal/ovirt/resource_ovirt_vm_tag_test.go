@@ -78,6 +78,12 @@ resource "ovirt_vm_tag" "test" {
 						},
 					),
 				},
+				{
+					Config:            config,
+					ImportState:       true,
+					ImportStateVerify: true,
+					ResourceName:      "ovirt_vm_tag.test",
+				},
 				{
 					Config:  config,
 					Destroy: true,
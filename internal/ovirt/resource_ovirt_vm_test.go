@@ -3,11 +3,15 @@ package ovirt
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -143,6 +147,98 @@ resource "ovirt_vm" "foo" {
 	)
 }
 
+func TestVMResourceImportReadsHardware(t *testing.T) {
+	t.Parallel()
+
+	// Special case: we are using the ovirtclientlog.NewTestLogger here because we call the client methods outside of
+	// the Terraform context.
+	p := newProvider(ovirtclientlog.NewTestLogger(t))
+	client := p.getTestHelper().GetClient()
+	clusterID := p.getTestHelper().GetClusterID()
+	templateID := p.getTestHelper().GetBlankTemplateID()
+
+	config := fmt.Sprintf(
+		`
+provider "ovirt" {
+	mock = true
+}
+
+resource "ovirt_vm" "foo" {
+	cluster_id  = "%s"
+	template_id = "%s"
+    name        = "test"
+}
+`,
+		clusterID,
+		templateID,
+	)
+
+	resource.UnitTest(
+		t, resource.TestCase{
+			ProviderFactories: p.getProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:       config,
+					ImportState:  true,
+					ResourceName: "ovirt_vm.foo",
+					ImportStateIdFunc: func(state *terraform.State) (string, error) {
+						params := ovirtclient.NewCreateVMParams().
+							MustWithCPUParameters(2, 1, 4).
+							MustWithMemory(2147483648).
+							WithMemoryPolicy(
+								ovirtclient.NewMemoryPolicyParameters().
+									MustWithMax(4294967296).
+									MustWithBallooning(true),
+							).
+							MustWithHugePages(ovirtclient.VMHugePages2M).
+							MustWithInitialization(ovirtclient.NewInitialization("echo hello", "vm-test-1")).
+							WithSerialConsole(true).
+							WithSoundcardEnabled(false)
+						vm, err := client.CreateVM(
+							clusterID,
+							templateID,
+							"test",
+							params,
+						)
+						if err != nil {
+							return "", fmt.Errorf("failed to create test VM (%w)", err)
+						}
+						return string(vm.ID()), nil
+					},
+					ImportStateCheck: func(states []*terraform.InstanceState) error {
+						if len(states) != 1 {
+							return fmt.Errorf("expected 1 imported resource, got %d", len(states))
+						}
+						expected := map[string]string{
+							"cpu_cores":                    "2",
+							"cpu_threads":                  "1",
+							"cpu_sockets":                  "4",
+							"memory":                       "2147483648",
+							"maximum_memory":               "4294967296",
+							"memory_ballooning":            "true",
+							"huge_pages":                   "2048",
+							"initialization_hostname":      "vm-test-1",
+							"initialization_custom_script": "echo hello",
+							"serial_console":               "true",
+							"soundcard_enabled":            "false",
+						}
+						for key, value := range expected {
+							if actual := states[0].Attributes[key]; actual != value {
+								return fmt.Errorf("incorrect value for %s after import: %s (expected %s)", key, actual, value)
+							}
+						}
+						return nil
+					},
+				},
+				{
+					Config:  config,
+					Destroy: true,
+				},
+			},
+		},
+	)
+}
+
 func TestVMResourceOSType(t *testing.T) {
 	t.Parallel()
 
@@ -293,6 +389,278 @@ resource "ovirt_vm" "foo" {
 	)
 }
 
+// vmProtocolTest drives the ovirt_vm resource through the protocol server of the SDK. Write-only attributes need
+// Terraform 1.11, so they are tested without the Terraform CLI.
+type vmProtocolTest struct {
+	t          *testing.T
+	p          *provider
+	server     tfprotov5.ProviderServer
+	resourceTy cty.Type
+}
+
+func newVMProtocolTest(t *testing.T) *vmProtocolTest {
+	p := newProvider(newTestLogger(t)).(*provider)
+	sdkProvider := p.getProvider()
+	if diags := sdkProvider.Configure(
+		context.Background(),
+		terraform.NewResourceConfigRaw(map[string]interface{}{"mock": true}),
+	); diags.HasError() {
+		t.Fatalf("failed to configure the provider (%v)", diags)
+	}
+	return &vmProtocolTest{
+		t:          t,
+		p:          p,
+		server:     schema.NewGRPCProviderServer(sdkProvider),
+		resourceTy: sdkProvider.ResourcesMap["ovirt_vm"].CoreConfigSchema().ImpliedType(),
+	}
+}
+
+// config returns the configuration of a VM with the specified attributes on top of the required ones.
+func (v *vmProtocolTest) config(attributes map[string]cty.Value) cty.Value {
+	values := map[string]cty.Value{}
+	for name, attributeType := range v.resourceTy.AttributeTypes() {
+		values[name] = cty.NullVal(attributeType)
+	}
+	values["cluster_id"] = cty.StringVal(string(v.p.getTestHelper().GetClusterID()))
+	values["template_id"] = cty.StringVal(string(v.p.getTestHelper().GetBlankTemplateID()))
+	values["name"] = cty.StringVal(v.p.getTestHelper().GenerateTestResourceName(v.t))
+	for name, value := range attributes {
+		values[name] = value
+	}
+	return cty.ObjectVal(values)
+}
+
+func (v *vmProtocolTest) encode(value cty.Value) *tfprotov5.DynamicValue {
+	encoded, err := msgpack.Marshal(value, v.resourceTy)
+	if err != nil {
+		v.t.Fatalf("failed to encode value (%v)", err)
+	}
+	return &tfprotov5.DynamicValue{MsgPack: encoded}
+}
+
+func (v *vmProtocolTest) decode(value *tfprotov5.DynamicValue) map[string]cty.Value {
+	decoded, err := msgpack.Unmarshal(value.MsgPack, v.resourceTy)
+	if err != nil {
+		v.t.Fatalf("failed to decode value (%v)", err)
+	}
+	return decoded.AsValueMap()
+}
+
+func (v *vmProtocolTest) validate(config cty.Value) []*tfprotov5.Diagnostic {
+	resp, err := v.server.ValidateResourceTypeConfig(
+		context.Background(), &tfprotov5.ValidateResourceTypeConfigRequest{
+			TypeName: "ovirt_vm",
+			Config:   v.encode(config),
+			ClientCapabilities: &tfprotov5.ValidateResourceTypeConfigClientCapabilities{
+				WriteOnlyAttributesAllowed: true,
+			},
+		},
+	)
+	if err != nil {
+		v.t.Fatalf("failed to validate the configuration (%v)", err)
+	}
+	return resp.Diagnostics
+}
+
+// plan plans the configuration on top of the prior state like Terraform does: the proposed new state takes the prior
+// value of computed attributes that are not configured, and write-only attributes are always null.
+func (v *vmProtocolTest) plan(prior cty.Value, config cty.Value) *tfprotov5.PlanResourceChangeResponse {
+	resourceSchema := v.p.vmResource().Schema
+	proposed := config.AsValueMap()
+	for name, value := range proposed {
+		switch {
+		case resourceSchema[name] != nil && resourceSchema[name].WriteOnly:
+			proposed[name] = cty.NullVal(value.Type())
+		case value.IsNull() && !prior.IsNull() && (name == "id" || resourceSchema[name] != nil && resourceSchema[name].Computed):
+			proposed[name] = prior.GetAttr(name)
+		}
+	}
+	resp, err := v.server.PlanResourceChange(
+		context.Background(), &tfprotov5.PlanResourceChangeRequest{
+			TypeName:         "ovirt_vm",
+			PriorState:       v.encode(prior),
+			ProposedNewState: v.encode(cty.ObjectVal(proposed)),
+			Config:           v.encode(config),
+		},
+	)
+	if err != nil {
+		v.t.Fatalf("failed to plan (%v)", err)
+	}
+	if len(resp.Diagnostics) != 0 {
+		v.t.Fatalf("failed to plan (%s: %s)", resp.Diagnostics[0].Summary, resp.Diagnostics[0].Detail)
+	}
+	return resp
+}
+
+// create plans and applies the configuration of a new VM and returns the new state.
+func (v *vmProtocolTest) create(config cty.Value) *tfprotov5.DynamicValue {
+	prior := cty.NullVal(v.resourceTy)
+	planResp := v.plan(prior, config)
+	resp, err := v.server.ApplyResourceChange(
+		context.Background(), &tfprotov5.ApplyResourceChangeRequest{
+			TypeName:       "ovirt_vm",
+			PriorState:     v.encode(prior),
+			PlannedState:   planResp.PlannedState,
+			PlannedPrivate: planResp.PlannedPrivate,
+			Config:         v.encode(config),
+		},
+	)
+	if err != nil {
+		v.t.Fatalf("failed to apply (%v)", err)
+	}
+	if len(resp.Diagnostics) != 0 {
+		v.t.Fatalf("failed to apply (%s: %s)", resp.Diagnostics[0].Summary, resp.Diagnostics[0].Detail)
+	}
+	return resp.NewState
+}
+
+func (v *vmProtocolTest) read(state *tfprotov5.DynamicValue) *tfprotov5.DynamicValue {
+	resp, err := v.server.ReadResource(
+		context.Background(), &tfprotov5.ReadResourceRequest{
+			TypeName:     "ovirt_vm",
+			CurrentState: state,
+		},
+	)
+	if err != nil {
+		v.t.Fatalf("failed to read (%v)", err)
+	}
+	if len(resp.Diagnostics) != 0 {
+		v.t.Fatalf("failed to read (%s: %s)", resp.Diagnostics[0].Summary, resp.Diagnostics[0].Detail)
+	}
+	return resp.NewState
+}
+
+func (v *vmProtocolTest) engineCustomScript(state map[string]cty.Value) string {
+	vm, err := v.p.getTestHelper().GetClient().GetVM(ovirtclient.VMID(state["id"].AsString()))
+	if err != nil {
+		v.t.Fatalf("failed to fetch VM (%v)", err)
+	}
+	return vm.Initialization().CustomScript()
+}
+
+func TestVMResourceInitializationWriteOnlyValidation(t *testing.T) {
+	t.Parallel()
+
+	v := newVMProtocolTest(t)
+	for name, testCase := range map[string]struct {
+		attributes    map[string]cty.Value
+		expectedError string
+	}{
+		"valid": {
+			attributes: map[string]cty.Value{
+				"initialization_custom_script_wo":         cty.StringVal("echo secret"),
+				"initialization_custom_script_wo_version": cty.NumberIntVal(1),
+			},
+		},
+		"both scripts": {
+			attributes: map[string]cty.Value{
+				"initialization_custom_script":            cty.StringVal("echo hello"),
+				"initialization_custom_script_wo":         cty.StringVal("echo secret"),
+				"initialization_custom_script_wo_version": cty.NumberIntVal(1),
+			},
+			expectedError: "conflicts with initialization_custom_script",
+		},
+		"missing version": {
+			attributes: map[string]cty.Value{
+				"initialization_custom_script_wo": cty.StringVal("echo secret"),
+			},
+			expectedError: "initialization_custom_script_wo_version",
+		},
+		"missing script": {
+			attributes: map[string]cty.Value{
+				"initialization_custom_script_wo_version": cty.NumberIntVal(1),
+			},
+			expectedError: "initialization_custom_script_wo",
+		},
+	} {
+		testCase := testCase
+		t.Run(
+			name, func(t *testing.T) {
+				diags := v.validate(v.config(testCase.attributes))
+				if testCase.expectedError == "" {
+					if len(diags) != 0 {
+						t.Fatalf("unexpected diagnostic (%s: %s)", diags[0].Summary, diags[0].Detail)
+					}
+					return
+				}
+				for _, d := range diags {
+					if d.Severity == tfprotov5.DiagnosticSeverityError &&
+						strings.Contains(d.Summary+" "+d.Detail, testCase.expectedError) {
+						return
+					}
+				}
+				t.Fatalf("no error mentioning %q in %d diagnostics", testCase.expectedError, len(diags))
+			},
+		)
+	}
+}
+
+func TestVMResourceInitializationWriteOnly(t *testing.T) {
+	t.Parallel()
+
+	v := newVMProtocolTest(t)
+	state := v.create(
+		v.config(
+			map[string]cty.Value{
+				"initialization_custom_script_wo":         cty.StringVal("echo secret"),
+				"initialization_custom_script_wo_version": cty.NumberIntVal(1),
+			},
+		),
+	)
+	values := v.decode(state)
+	if script := v.engineCustomScript(values); script != "echo secret" {
+		t.Fatalf("the write-only script was not passed to the engine: %q", script)
+	}
+	if !values["initialization_custom_script_wo"].IsNull() {
+		t.Fatalf("the write-only script was stored in the state")
+	}
+	if script := values["initialization_custom_script"]; !script.IsNull() && script.AsString() != "" {
+		t.Fatalf("the script was stored in the state after create: %q", script.AsString())
+	}
+
+	// The engine returns the script, which must not be written back on refresh.
+	values = v.decode(v.read(state))
+	if script := values["initialization_custom_script"]; !script.IsNull() && script.AsString() != "" {
+		t.Fatalf("the script was stored in the state after refresh: %q", script.AsString())
+	}
+}
+
+func TestVMResourceInitializationWriteOnlyMigration(t *testing.T) {
+	t.Parallel()
+
+	v := newVMProtocolTest(t)
+	plainConfig := v.config(map[string]cty.Value{"initialization_custom_script": cty.StringVal("echo secret")})
+	state := v.create(plainConfig)
+	if script := v.decode(state)["initialization_custom_script"].AsString(); script != "echo secret" {
+		t.Fatalf("incorrect script in the state: %q", script)
+	}
+
+	writeOnlyConfig := plainConfig.AsValueMap()
+	writeOnlyConfig["initialization_custom_script"] = cty.NullVal(cty.String)
+	writeOnlyConfig["initialization_custom_script_wo"] = cty.StringVal("echo secret")
+	writeOnlyConfig["initialization_custom_script_wo_version"] = cty.NumberIntVal(1)
+	prior, err := msgpack.Unmarshal(state.MsgPack, v.resourceTy)
+	if err != nil {
+		t.Fatalf("failed to decode state (%v)", err)
+	}
+	planResp := v.plan(prior, cty.ObjectVal(writeOnlyConfig))
+	if len(planResp.RequiresReplace) == 0 {
+		t.Fatalf("switching to the write-only script doesn't replace the VM")
+	}
+	planned := v.decode(planResp.PlannedState)
+	if script := planned["initialization_custom_script"]; script.IsKnown() && !script.IsNull() && script.AsString() != "" {
+		t.Fatalf("the plan keeps the script in the state: %q", script.AsString())
+	}
+
+	// A script stored in the state is also removed on refresh once the write-only variant is in use.
+	priorValues := prior.AsValueMap()
+	priorValues["initialization_custom_script_wo_version"] = cty.NumberIntVal(1)
+	values := v.decode(v.read(v.encode(cty.ObjectVal(priorValues))))
+	if script := values["initialization_custom_script"]; !script.IsNull() && script.AsString() != "" {
+		t.Fatalf("the script was kept in the state on refresh: %q", script.AsString())
+	}
+}
+
 func TestVMResourceInitializationWithNicConfiguration(t *testing.T) {
 	t.Parallel()
 
@@ -701,6 +1069,8 @@ type testVM struct {
 	templateID      ovirtclient.TemplateID
 	status          ovirtclient.VMStatus
 	os              ovirtclient.VMOS
+	vmType          ovirtclient.VMType
+	memory          int64
 	placementPolicy ovirtclient.VMPlacementPolicy
 }
 
@@ -709,11 +1079,11 @@ func (t *testVM) Description() string {
 }
 
 func (t *testVM) InstanceTypeID() *ovirtclient.InstanceTypeID {
-	panic("not implemented for test input")
+	return nil
 }
 
 func (t *testVM) VMType() ovirtclient.VMType {
-	panic("not implemented for test input")
+	return t.vmType
 }
 
 func (t *testVM) OS() ovirtclient.VMOS {
@@ -721,11 +1091,11 @@ func (t *testVM) OS() ovirtclient.VMOS {
 }
 
 func (t *testVM) Memory() int64 {
-	panic("not implemented for test input")
+	return t.memory
 }
 
 func (t *testVM) MemoryPolicy() ovirtclient.MemoryPolicy {
-	panic("not implemented for test input")
+	return nil
 }
 
 func (t *testVM) TagIDs() []ovirtclient.TagID {
@@ -733,11 +1103,11 @@ func (t *testVM) TagIDs() []ovirtclient.TagID {
 }
 
 func (t *testVM) HugePages() *ovirtclient.VMHugePages {
-	panic("not implemented for test input")
+	return nil
 }
 
 func (t *testVM) Initialization() ovirtclient.Initialization {
-	panic("not implemented for test input")
+	return nil
 }
 
 func (t *testVM) HostID() *ovirtclient.HostID {
@@ -766,7 +1136,7 @@ type testCPU struct {
 }
 
 func (t testCPU) Mode() *ovirtclient.CPUMode {
-	panic("not implemented for test input")
+	return nil
 }
 
 type testTopo struct {
@@ -841,6 +1211,8 @@ func TestVMResourceUpdate(t *testing.T) {
 		os: &testOS{
 			t: "linux",
 		},
+		vmType: ovirtclient.VMTypeServer,
+		memory: 1073741824,
 		placementPolicy: &testPlacementPolicy{
 			&vmAffinity,
 			[]ovirtclient.HostID{"asdf"},
@@ -857,10 +1229,40 @@ func TestVMResourceUpdate(t *testing.T) {
 	compareResource(t, resourceData, "template_id", string(vm.templateID))
 	compareResource(t, resourceData, "status", string(vm.status))
 	compareResource(t, resourceData, "os_type", vm.os.Type())
+	compareResource(t, resourceData, "vm_type", string(vm.vmType))
+	compareResource(t, resourceData, "memory", "1073741824")
 	compareResource(t, resourceData, "placement_policy_affinity", string(*vm.placementPolicy.Affinity()))
 	compareResourceStringList(t, resourceData, "placement_policy_host_ids", []string{"asdf"})
 }
 
+func TestVMResourceUpdateConsoleAndSoundcard(t *testing.T) {
+	t.Parallel()
+
+	// Special case: we are using the ovirtclientlog.NewTestLogger here because we call the client methods outside of
+	// the Terraform context.
+	p := newProvider(ovirtclientlog.NewTestLogger(t))
+	helper := p.getTestHelper()
+	vm, err := helper.GetClient().CreateVM(
+		helper.GetClusterID(),
+		helper.GetBlankTemplateID(),
+		helper.GenerateTestResourceName(t),
+		ovirtclient.NewCreateVMParams().WithSerialConsole(true).WithSoundcardEnabled(false),
+	)
+	if err != nil {
+		t.Fatalf("failed to create test VM (%v)", err)
+	}
+	resourceData := schema.TestResourceDataRaw(t, vmSchema, map[string]interface{}{})
+	if diags := vmResourceUpdate(vm, resourceData); len(diags) != 0 {
+		t.Fatalf("failed to convert VM resource (%v)", diags)
+	}
+	if !resourceData.Get("serial_console").(bool) {
+		t.Fatalf("serial_console was not read back")
+	}
+	if resourceData.Get("soundcard_enabled").(bool) {
+		t.Fatalf("soundcard_enabled was not read back")
+	}
+}
+
 func compareResource(t *testing.T, data *schema.ResourceData, field string, value string) {
 	if resourceValue := data.Get(field); resourceValue != value {
 		t.Fatalf("invalid resource %s: %s, expected: %s", field, resourceValue, value)
@@ -1531,3 +1933,123 @@ func TestHugePages(t *testing.T) {
 		},
 	)
 }
+
+func TestVMResourceCustomizeDiff(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(newTestLogger(t)).(*provider)
+	providerData := schema.TestResourceDataRaw(t, providerSchema, map[string]interface{}{"mock": true})
+	if _, diags := p.configureProvider(context.Background(), providerData); diags.HasError() {
+		t.Fatalf("failed to configure provider (%v)", diags)
+	}
+	baseConfig := map[string]interface{}{
+		"cluster_id":  string(p.getTestHelper().GetClusterID()),
+		"template_id": string(p.getTestHelper().GetBlankTemplateID()),
+		"name":        p.getTestHelper().GenerateTestResourceName(t),
+	}
+
+	for name, testCase := range map[string]struct {
+		config        map[string]interface{}
+		expectedPath  cty.Path
+		expectedError string
+	}{
+		"valid": {
+			config: map[string]interface{}{"memory": "2GiB", "maximum_memory": "4GiB", "huge_pages": 1048576},
+		},
+		"memory exceeds maximum_memory": {
+			config:        map[string]interface{}{"memory": "4GiB", "maximum_memory": "2GiB"},
+			expectedPath:  cty.GetAttrPath("memory"),
+			expectedError: "4294967296 bytes of memory exceed the maximum_memory of 2147483648 bytes",
+		},
+		"memory not divisible by huge pages": {
+			config:        map[string]interface{}{"memory": "1536MiB", "huge_pages": 1048576},
+			expectedPath:  cty.GetAttrPath("huge_pages"),
+			expectedError: "1610612736 bytes of memory are not a multiple of the 1048576 KiB huge page size",
+		},
+		"nonexistent template": {
+			config:        map[string]interface{}{"template_id": "e6a4d8a2-7f45-4d0b-9c7e-1f2a3b4c5d6e"},
+			expectedPath:  cty.GetAttrPath("template_id"),
+			expectedError: "template e6a4d8a2-7f45-4d0b-9c7e-1f2a3b4c5d6e does not exist",
+		},
+		"nonexistent instance type": {
+			config:        map[string]interface{}{"instance_type_id": "e6a4d8a2-7f45-4d0b-9c7e-1f2a3b4c5d6e"},
+			expectedPath:  cty.GetAttrPath("instance_type_id"),
+			expectedError: "instance type e6a4d8a2-7f45-4d0b-9c7e-1f2a3b4c5d6e does not exist",
+		},
+		"several errors": {
+			config: map[string]interface{}{
+				"memory":         "4GiB",
+				"maximum_memory": "2GiB",
+				"template_id":    "e6a4d8a2-7f45-4d0b-9c7e-1f2a3b4c5d6e",
+			},
+			expectedError: "ovirt_vm.template_id: template e6a4d8a2-7f45-4d0b-9c7e-1f2a3b4c5d6e does not exist",
+		},
+	} {
+		config := map[string]interface{}{}
+		for key, value := range baseConfig {
+			config[key] = value
+		}
+		for key, value := range testCase.config {
+			config[key] = value
+		}
+		_, err := p.vmResource().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), p)
+		switch {
+		case testCase.expectedError == "" && err != nil:
+			t.Fatalf("%s: unexpected error (%v)", name, err)
+		case testCase.expectedError != "" && err == nil:
+			t.Fatalf("%s: no error returned", name)
+		case testCase.expectedError != "" && !strings.Contains(err.Error(), testCase.expectedError):
+			t.Fatalf("%s: unexpected error (%v)", name, err)
+		}
+		if testCase.expectedPath == nil {
+			continue
+		}
+		var pathErr cty.PathError
+		if !errors.As(err, &pathErr) || !pathErr.Path.Equals(testCase.expectedPath) {
+			t.Fatalf("%s: error doesn't point to the attribute %#v (%v)", name, testCase.expectedPath, err)
+		}
+	}
+}
+
+func TestVMResourceCustomizeDiffWithoutLookups(t *testing.T) {
+	t.Parallel()
+
+	config := map[string]interface{}{
+		"cluster_id":  "0b94d8a2-7f45-4d0b-9c7e-1f2a3b4c5d6e",
+		"template_id": "e6a4d8a2-7f45-4d0b-9c7e-1f2a3b4c5d6e",
+		"name":        "test",
+	}
+
+	// The client is only set once the provider is configured.
+	unconfigured := newProvider(newTestLogger(t)).(*provider)
+	if _, err := unconfigured.vmResource().Diff(
+		context.Background(), nil, terraform.NewResourceConfigRaw(config), unconfigured,
+	); err != nil {
+		t.Fatalf("references were checked without a client (%v)", err)
+	}
+
+	p := newProvider(newTestLogger(t)).(*provider)
+	providerData := schema.TestResourceDataRaw(t, providerSchema, map[string]interface{}{"mock": true})
+	if _, diags := p.configureProvider(context.Background(), providerData); diags.HasError() {
+		t.Fatalf("failed to configure provider (%v)", diags)
+	}
+	var lookups []string
+	p.client = wrapClient(
+		p.client,
+		func(_ ovirtclient.Client, method string, retries []ovirtclient.RetryStrategy, next clientCall) error {
+			lookups = append(lookups, method)
+			return next(retries)
+		},
+	)
+	// Values that are only known after apply are passed as this placeholder.
+	config["cluster_id"] = "74D93920-ED26-11E3-AC10-0800200C9A66"
+	config["template_id"] = "74D93920-ED26-11E3-AC10-0800200C9A66"
+	if _, err := p.vmResource().Diff(
+		context.Background(), nil, terraform.NewResourceConfigRaw(config), p,
+	); err != nil {
+		t.Fatalf("unexpected error for unknown references (%v)", err)
+	}
+	if len(lookups) != 0 {
+		t.Fatalf("unknown references were looked up: %v", lookups)
+	}
+}
@@ -0,0 +1,108 @@
+package ovirt
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+var vnicProfileSchema = map[string]*schema.Schema{
+	"id": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "Human-readable name for the VNIC profile.",
+		ForceNew:         true,
+		ValidateDiagFunc: validateNonEmpty,
+	},
+	"network_id": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "ID of the logical network this VNIC profile belongs to.",
+		ForceNew:         true,
+		ValidateDiagFunc: validateUUID,
+	},
+}
+
+func (p *provider) vnicProfileResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: p.vnicProfileCreate,
+		ReadContext:   p.vnicProfileRead,
+		DeleteContext: p.vnicProfileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: p.vnicProfileImport,
+		},
+		Schema: vnicProfileSchema,
+		Description: `The ovirt_vnic_profile resource creates VNIC profiles on a logical network in oVirt.
+
+-> Port mirroring, network filters, pass-through and QoS settings are not yet supported by the underlying oVirt client library and are left at the engine defaults.`,
+	}
+}
+
+func (p *provider) vnicProfileCreate(ctx context.Context, data *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	client := p.client.WithContext(ctx)
+	name := data.Get("name").(string)
+	networkID := data.Get("network_id").(string)
+	vnicProfile, err := client.CreateVNICProfile(
+		name,
+		ovirtclient.NetworkID(networkID),
+		ovirtclient.CreateVNICProfileParams(),
+	)
+	if err != nil {
+		return errorToDiags(fmt.Sprintf("create VNIC profile %s", name), err)
+	}
+	return vnicProfileResourceUpdate(vnicProfile, data)
+}
+
+func (p *provider) vnicProfileRead(ctx context.Context, data *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	client := p.client.WithContext(ctx)
+	vnicProfile, err := client.GetVNICProfile(ovirtclient.VNICProfileID(data.Id()))
+	if err != nil {
+		if isNotFound(err) {
+			data.SetId("")
+			return nil
+		}
+		return errorToDiags(fmt.Sprintf("get VNIC profile %s", data.Id()), err)
+	}
+	return vnicProfileResourceUpdate(vnicProfile, data)
+}
+
+func (p *provider) vnicProfileDelete(ctx context.Context, data *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	client := p.client.WithContext(ctx)
+	if err := client.RemoveVNICProfile(ovirtclient.VNICProfileID(data.Id())); err != nil {
+		if !isNotFound(err) {
+			return errorToDiags(fmt.Sprintf("remove VNIC profile %s", data.Id()), err)
+		}
+	}
+	data.SetId("")
+	return nil
+}
+
+func (p *provider) vnicProfileImport(ctx context.Context, data *schema.ResourceData, _ interface{}) (
+	[]*schema.ResourceData,
+	error,
+) {
+	client := p.client.WithContext(ctx)
+	vnicProfile, err := client.GetVNICProfile(ovirtclient.VNICProfileID(data.Id()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to import VNIC profile %s (%w)", data.Id(), err)
+	}
+	if diags := vnicProfileResourceUpdate(vnicProfile, data); diags.HasError() {
+		return nil, diagsToError(diags)
+	}
+	return []*schema.ResourceData{data}, nil
+}
+
+func vnicProfileResourceUpdate(vnicProfile ovirtclient.VNICProfileData, data *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	data.SetId(string(vnicProfile.ID()))
+	diags = setResourceField(data, "name", vnicProfile.Name(), diags)
+	diags = setResourceField(data, "network_id", vnicProfile.NetworkID(), diags)
+	return diags
+}
@@ -0,0 +1,69 @@
+package ovirt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+func getTestNetworkID(t *testing.T, p providerInterface) ovirtclient.NetworkID {
+	helper := p.getTestHelper()
+	vnicProfile, err := helper.GetClient().GetVNICProfile(helper.GetVNICProfileID())
+	if err != nil {
+		t.Fatalf("failed to fetch test VNIC profile (%v)", err)
+	}
+	return vnicProfile.NetworkID()
+}
+
+func TestVNICProfileResource(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(newTestLogger(t))
+	networkID := getTestNetworkID(t, p)
+	name := fmt.Sprintf("%s-%s", t.Name(), p.getTestHelper().GenerateRandomID(5))
+	config := fmt.Sprintf(
+		`
+provider "ovirt" {
+	mock = true
+}
+
+resource "ovirt_vnic_profile" "foo" {
+	name       = "%s"
+	network_id = "%s"
+}
+`,
+		name,
+		networkID,
+	)
+
+	resource.UnitTest(
+		t, resource.TestCase{
+			ProviderFactories: p.getProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("ovirt_vnic_profile.foo", "name", name),
+						resource.TestCheckResourceAttr("ovirt_vnic_profile.foo", "network_id", string(networkID)),
+					),
+				},
+				{
+					Config:            config,
+					ResourceName:      "ovirt_vnic_profile.foo",
+					ImportState:       true,
+					ImportStateVerify: true,
+					ImportStateIdFunc: func(state *terraform.State) (string, error) {
+						return state.RootModule().Resources["ovirt_vnic_profile.foo"].Primary.ID, nil
+					},
+				},
+				{
+					Config:  config,
+					Destroy: true,
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,225 @@
+package ovirt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// sizeUnits are the unit suffixes accepted in size attributes, in the order they are tried when formatting sizes.
+var sizeUnits = []struct {
+	suffix string
+	bytes  uint64
+}{
+	{"PiB", 1 << 50},
+	{"TiB", 1 << 40},
+	{"GiB", 1 << 30},
+	{"MiB", 1 << 20},
+	{"KiB", 1 << 10},
+	{"PB", 1e15},
+	{"TB", 1e12},
+	{"GB", 1e9},
+	{"MB", 1e6},
+	{"KB", 1e3},
+	{"B", 1},
+}
+
+var sizePattern = regexp.MustCompile(`^\s*([0-9]+)\s*([A-Za-z]*)\s*$`)
+
+// sizeDescription returns the description of a size attribute, explaining the accepted formats and the alignment.
+func sizeDescription(description string, alignment uint64) string {
+	return fmt.Sprintf(
+		"%s Accepts a number of bytes or a string with a unit suffix, such as `20GiB`, `512MiB` or `1TB`, and is stored in bytes. Must be a multiple of %s. The attribute is a string (it used to be a number), so use `tonumber()` to do arithmetic with it.",
+		description,
+		humanSizeDescription(alignment),
+	)
+}
+
+func humanSizeDescription(size uint64) string {
+	if size < 1<<10 {
+		return fmt.Sprintf("%d bytes", size)
+	}
+	return humanSize(size)
+}
+
+// memoryAlignment is the granularity of memory sizes. The engine manages VM memory in MiB.
+const memoryAlignment = 1 << 20
+
+// diskAlignment is the granularity of disk sizes. Disks are allocated in 512 byte sectors.
+const diskAlignment = 512
+
+// parseSize parses a size in bytes with an optional unit suffix, such as 20GiB or 1TB. Units are case-insensitive.
+func parseSize(value string) (uint64, error) {
+	match := sizePattern.FindStringSubmatch(value)
+	if match == nil {
+		return 0, fmt.Errorf(
+			"invalid size %q, the size must be a whole number of bytes with an optional unit such as 20GiB or 1TB",
+			value,
+		)
+	}
+	number, err := strconv.ParseUint(match[1], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size %q (%w)", value, err)
+	}
+	unit := uint64(1)
+	if match[2] != "" {
+		found := false
+		for _, u := range sizeUnits {
+			if strings.EqualFold(match[2], u.suffix) {
+				unit = u.bytes
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, fmt.Errorf(
+				"invalid unit %q in size %q, supported units are: %s",
+				match[2],
+				value,
+				strings.Join(sizeUnitSuffixes(), ", "),
+			)
+		}
+	}
+	if number > math.MaxInt64/unit {
+		return 0, fmt.Errorf("size %q is too large", value)
+	}
+	return number * unit, nil
+}
+
+func sizeUnitSuffixes() []string {
+	suffixes := make([]string, len(sizeUnits))
+	for i, u := range sizeUnits {
+		suffixes[i] = u.suffix
+	}
+	return suffixes
+}
+
+// sizeValue converts the value of a size attribute to bytes. Unset attributes are 0.
+func sizeValue(value interface{}) (uint64, error) {
+	if value == nil || value == "" {
+		return 0, nil
+	}
+	return parseSize(value.(string))
+}
+
+// formatSize formats a size in bytes as it is stored in the state.
+func formatSize(size uint64) string {
+	return strconv.FormatUint(size, 10)
+}
+
+// humanSize formats a size with the largest binary unit that divides it evenly, for generated configuration.
+func humanSize(size uint64) string {
+	for _, u := range sizeUnits {
+		if strings.HasSuffix(u.suffix, "iB") && size != 0 && size%u.bytes == 0 {
+			return fmt.Sprintf("%d%s", size/u.bytes, u.suffix)
+		}
+	}
+	return formatSize(size)
+}
+
+// normalizeSize is the StateFunc of size attributes. It stores sizes in bytes so that 20GiB and 21474836480 don't
+// cause a diff. Invalid sizes are stored unchanged and rejected by validateSize.
+func normalizeSize(value interface{}) string {
+	size, err := sizeValue(value)
+	if err != nil {
+		return value.(string)
+	}
+	return formatSize(size)
+}
+
+// validateSize returns a validation function for size attributes that checks that the size is positive and a
+// multiple of alignment bytes.
+func validateSize(alignment uint64) schema.SchemaValidateDiagFunc {
+	return func(i interface{}, path cty.Path) diag.Diagnostics {
+		value, ok := i.(string)
+		if !ok {
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity:      diag.Error,
+					Summary:       "Not a size",
+					Detail:        "The specified value is not a number or a string.",
+					AttributePath: path,
+				},
+			}
+		}
+		size, err := parseSize(value)
+		if err != nil {
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity:      diag.Error,
+					Summary:       "Invalid size",
+					Detail:        err.Error(),
+					AttributePath: path,
+				},
+			}
+		}
+		if size == 0 {
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity:      diag.Error,
+					Summary:       "Invalid size",
+					Detail:        "The size must be positive.",
+					AttributePath: path,
+				},
+			}
+		}
+		if size%alignment != 0 {
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Misaligned size",
+					Detail: fmt.Sprintf(
+						"The size %s (%d bytes) must be a multiple of %s as required by the oVirt Engine.",
+						value,
+						size,
+						humanSizeDescription(alignment),
+					),
+					AttributePath: path,
+				},
+			}
+		}
+		return nil
+	}
+}
+
+// sizeStateUpgrader upgrades the state from schema version 0, where the size attributes of the resource were
+// integers, to version 1, where they are strings.
+func sizeStateUpgrader(resource *schema.Resource, attributes ...string) schema.StateUpgrader {
+	v0Schema := make(map[string]*schema.Schema, len(resource.Schema))
+	for name, attributeSchema := range resource.Schema {
+		v0Schema[name] = attributeSchema
+	}
+	for _, attribute := range attributes {
+		v0Attribute := *resource.Schema[attribute]
+		v0Attribute.Type = schema.TypeInt
+		v0Attribute.StateFunc = nil
+		v0Attribute.ValidateDiagFunc = nil
+		v0Schema[attribute] = &v0Attribute
+	}
+	v0Resource := *resource
+	v0Resource.Schema = v0Schema
+
+	return schema.StateUpgrader{
+		Version: 0,
+		Type:    v0Resource.CoreConfigSchema().ImpliedType(),
+		Upgrade: func(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+			for _, attribute := range attributes {
+				switch value := rawState[attribute].(type) {
+				case float64:
+					rawState[attribute] = strconv.FormatFloat(value, 'f', 0, 64)
+				case json.Number:
+					rawState[attribute] = value.String()
+				}
+			}
+			return rawState, nil
+		},
+	}
+}
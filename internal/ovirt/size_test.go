@@ -0,0 +1,105 @@
+package ovirt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestParseSize(t *testing.T) {
+	t.Parallel()
+
+	for input, expected := range map[string]uint64{
+		"21474836480": 21474836480,
+		"20GiB":       21474836480,
+		"20 GiB":      21474836480,
+		"512MiB":      536870912,
+		"512mib":      536870912,
+		"1TB":         1000000000000,
+		"4KB":         4000,
+		"100B":        100,
+	} {
+		size, err := parseSize(input)
+		if err != nil {
+			t.Fatalf("failed to parse %q (%v)", input, err)
+		}
+		if size != expected {
+			t.Fatalf("incorrect size for %q (expected: %d, got: %d)", input, expected, size)
+		}
+	}
+	for _, input := range []string{"", "GiB", "1.5GiB", "-1", "20GB ish", "20XB", "9223372036854775808", "8EiB", "9000PiB"} {
+		if _, err := parseSize(input); err == nil {
+			t.Fatalf("invalid size %q did not fail to parse", input)
+		}
+	}
+}
+
+func TestHumanSize(t *testing.T) {
+	t.Parallel()
+
+	for size, expected := range map[uint64]string{
+		21474836480: "20GiB",
+		1048576:     "1MiB",
+		1536:        "1536",
+		1000:        "1000",
+	} {
+		if formatted := humanSize(size); formatted != expected {
+			t.Fatalf("incorrect formatting for %d (expected: %s, got: %s)", size, expected, formatted)
+		}
+	}
+}
+
+func TestValidateSize(t *testing.T) {
+	t.Parallel()
+
+	validate := validateSize(memoryAlignment)
+	for _, valid := range []string{"512MiB", "2147483648", "1GiB"} {
+		if diags := validate(valid, cty.Path{}); diags.HasError() {
+			t.Fatalf("valid size %q was rejected (%v)", valid, diags)
+		}
+	}
+	for _, invalid := range []string{"0", "1GB", "1000KiB", "big"} {
+		if diags := validate(invalid, cty.Path{}); !diags.HasError() {
+			t.Fatalf("invalid size %q was not rejected", invalid)
+		}
+	}
+	if diags := validateSize(diskAlignment)("1TB", cty.Path{}); diags.HasError() {
+		t.Fatalf("1TB disk size was rejected (%v)", diags)
+	}
+}
+
+func TestSizeStateUpgrader(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(newTestLogger(t)).(*provider)
+	for name, resource := range p.getProvider().ResourcesMap {
+		for _, upgrader := range resource.StateUpgraders {
+			if !upgrader.Type.IsObjectType() {
+				t.Fatalf("state upgrader of %s has an invalid type", name)
+			}
+		}
+	}
+
+	upgrader := p.vmResource().StateUpgraders[0]
+	if upgrader.Type.AttributeType("memory") != cty.Number {
+		t.Fatalf("memory is not a number in the version 0 schema")
+	}
+	state, err := upgrader.Upgrade(
+		context.Background(),
+		map[string]interface{}{"id": "test", "memory": float64(2147483648), "maximum_memory": nil, "name": "test"},
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("failed to upgrade state (%v)", err)
+	}
+	if state["memory"] != "2147483648" {
+		t.Fatalf("incorrect upgraded memory: %v", state["memory"])
+	}
+	if state["maximum_memory"] != nil {
+		t.Fatalf("unset maximum_memory was changed: %v", state["maximum_memory"])
+	}
+	if state["name"] != "test" {
+		t.Fatalf("unrelated attribute was changed: %v", state["name"])
+	}
+}
@@ -3,13 +3,20 @@ package ovirt
 import (
 	"errors"
 	"fmt"
+	"reflect"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
 )
 
+// defaultTimeout is the time resources waiting on the engine are given for each operation unless the user overrides it
+// in a timeouts block. It matches the default the Terraform SDK applies when no timeouts are declared.
+const defaultTimeout = 20 * time.Minute
+
 func schemaMerge(schema1, schema2 map[string]*schema.Schema) map[string]*schema.Schema {
 	result := make(map[string]*schema.Schema, len(schema1)+len(schema2))
 	for k, v := range schema1 {
@@ -21,32 +28,6 @@ func schemaMerge(schema1, schema2 map[string]*schema.Schema) map[string]*schema.
 	return result
 }
 
-func extractString(data *schema.ResourceData, option string, diags diag.Diagnostics) (string, diag.Diagnostics) {
-	var url string
-	urlInterface, ok := data.GetOk(option)
-	if !ok {
-		diags = append(
-			diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  fmt.Sprintf("The %s option is not set", option),
-				Detail:   fmt.Sprintf("The %s option must be set if mock=false", option),
-			},
-		)
-	} else {
-		url, ok = urlInterface.(string)
-		if !ok {
-			diags = append(
-				diags, diag.Diagnostic{
-					Severity: diag.Error,
-					Summary:  fmt.Sprintf("The %s option is not a string", option),
-					Detail:   fmt.Sprintf("The %s option must be set and be a string", option),
-				},
-			)
-		}
-	}
-	return url, diags
-}
-
 func setResourceField(
 	data *schema.ResourceData,
 	field string,
@@ -76,6 +57,16 @@ func isNotFound(err error) bool {
 	return false
 }
 
+// isNil returns true if the value is nil or an interface holding a nil pointer. Some go-ovirt-client accessors
+// return typed nil pointers, which a plain nil comparison does not catch.
+func isNil(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	v := reflect.ValueOf(value)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
+
 func diagsToError(diags diag.Diagnostics) error {
 	if !diags.HasError() {
 		return nil
@@ -111,3 +102,42 @@ func appendDiags(diags diag.Diagnostics, action string, err error) diag.Diagnost
 	}
 	return append(diags, errorToDiag(action, err))
 }
+
+// attributeDiag returns an error diagnostic pointing at a top-level attribute of a resource.
+func attributeDiag(attribute string, summary string) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity:      diag.Error,
+		Summary:       summary,
+		AttributePath: cty.GetAttrPath(attribute),
+	}
+}
+
+// customizeDiffError converts the diagnostics collected in a CustomizeDiff function into the error it must return.
+// The SDK only keeps the attribute path of a single cty.PathError, so a single diagnostic keeps its path while several
+// are joined into one error that names the attribute of each.
+func customizeDiffError(resourceType string, diags diag.Diagnostics) error {
+	if !diags.HasError() {
+		return nil
+	}
+	if len(diags) == 1 {
+		return diags[0].AttributePath.NewErrorf("%s", diags[0].Summary)
+	}
+	errs := make([]error, len(diags))
+	for i, d := range diags {
+		if step, ok := attributePathRoot(d.AttributePath); ok {
+			errs[i] = fmt.Errorf("%s.%s: %s", resourceType, step, d.Summary)
+		} else {
+			errs[i] = errors.New(d.Summary)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// attributePathRoot returns the name of the top-level attribute of the path.
+func attributePathRoot(path cty.Path) (string, bool) {
+	if len(path) == 0 {
+		return "", false
+	}
+	step, ok := path[0].(cty.GetAttrStep)
+	return step.Name, ok
+}
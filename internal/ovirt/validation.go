@@ -8,6 +8,7 @@ import (
 	"regexp"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/go-version"
@@ -16,30 +17,6 @@ import (
 	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
 )
 
-func validateDiskSize(i interface{}, path cty.Path) diag.Diagnostics {
-	size, ok := i.(int)
-	if !ok {
-		return diag.Diagnostics{
-			diag.Diagnostic{
-				Severity:      diag.Error,
-				Summary:       "Disk size must be an integer.",
-				Detail:        "The provided disk size is not an integer.",
-				AttributePath: path,
-			},
-		}
-	}
-	if size <= 0 {
-		return diag.Diagnostics{
-			diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  "Disk size must be a positive integer.",
-				Detail:   fmt.Sprintf("The provided disk size must be a positive integer, got %d.", size),
-			},
-		}
-	}
-	return nil
-}
-
 func validateLocalFile(i interface{}, p cty.Path) diag.Diagnostics {
 	val, ok := i.(string)
 	if !ok {
@@ -368,3 +345,93 @@ func validateMacAddress(i interface{}, path cty.Path) diag.Diagnostics {
 	}
 	return nil
 }
+
+func validateDuration(i interface{}, path cty.Path) diag.Diagnostics {
+	val, ok := i.(string)
+	if !ok {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Not a string",
+				Detail:        "The specified value is not a string.",
+				AttributePath: path,
+			},
+		}
+	}
+	duration, err := time.ParseDuration(val)
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Not a valid duration",
+				Detail:        fmt.Sprintf("The specified value is not a valid duration, such as 5m or 1h30m (%v).", err),
+				AttributePath: path,
+			},
+		}
+	}
+	if duration <= 0 {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Not a positive duration",
+				Detail:        "The specified duration must be positive.",
+				AttributePath: path,
+			},
+		}
+	}
+	return nil
+}
+
+func validateIntBetween(minValue int, maxValue int) schema.SchemaValidateDiagFunc {
+	return func(i interface{}, path cty.Path) diag.Diagnostics {
+		val, ok := i.(int)
+		if !ok {
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity:      diag.Error,
+					Summary:       "Not an integer",
+					Detail:        "The specified value is not an integer.",
+					AttributePath: path,
+				},
+			}
+		}
+		if val < minValue || val > maxValue {
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity:      diag.Error,
+					Summary:       "Value out of range",
+					Detail:        fmt.Sprintf("The specified value must be between %d and %d.", minValue, maxValue),
+					AttributePath: path,
+				},
+			}
+		}
+		return nil
+	}
+}
+
+func validateFloatBetween(minValue float64, maxValue float64) schema.SchemaValidateDiagFunc {
+	return func(i interface{}, path cty.Path) diag.Diagnostics {
+		val, ok := i.(float64)
+		if !ok {
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity:      diag.Error,
+					Summary:       "Not a number",
+					Detail:        "The specified value is not a number.",
+					AttributePath: path,
+				},
+			}
+		}
+		if val < minValue || val > maxValue {
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity:      diag.Error,
+					Summary:       "Value out of range",
+					Detail:        fmt.Sprintf("The specified value must be between %g and %g.", minValue, maxValue),
+					AttributePath: path,
+				},
+			}
+		}
+		return nil
+	}
+}
@@ -2,12 +2,34 @@ package main
 
 import (
 	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 	"github.com/ovirt/terraform-provider-ovirt/v2/internal/ovirt"
 )
 
+// subcommands are the commands that can be run instead of the plugin server.
+var subcommands = map[string]func(args []string) error{
+	"export-mock-fixtures": exportMockFixtures,
+	"generate-hcl":         generateHCL,
+}
+
 func main() {
+	if len(os.Args) > 1 {
+		if subcommand, ok := subcommands[os.Args[1]]; ok {
+			if err := subcommand(os.Args[2:]); err != nil {
+				log.Fatal(err)
+			}
+			return
+		}
+	}
+
 	var debugMode bool
 
 	flag.BoolVar(
@@ -18,9 +40,13 @@ func main() {
 	)
 	flag.Parse()
 
+	providerFunc := ovirt.New()
 	opts := &plugin.ServeOpts{
 		ProviderAddr: "registry.terraform.io/oVirt/ovirt",
-		ProviderFunc: ovirt.New(),
+		// The SDK doesn't support provider functions, so they are added to the protocol server of the provider.
+		GRPCProviderFunc: func() tfprotov5.ProviderServer {
+			return ovirt.WithFunctions(schema.NewGRPCProviderServer(providerFunc()))
+		},
 	}
 
 	if debugMode {
@@ -29,3 +55,36 @@ func main() {
 
 	plugin.Serve(opts)
 }
+
+// writeOutput writes the output of a subcommand to the specified file, or to the standard output if the file name is
+// empty. The output is written to a temporary file next to it first, so that the file is only replaced once the
+// output is complete.
+func writeOutput(file string, write func(w io.Writer) error) error {
+	if file == "" {
+		return write(os.Stdout)
+	}
+	fh, err := os.CreateTemp(filepath.Dir(file), "."+filepath.Base(file)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file for %s (%w)", file, err)
+	}
+	tempFile := fh.Name()
+	if err := write(fh); err != nil {
+		_ = fh.Close()
+		_ = os.Remove(tempFile)
+		return err
+	}
+	if err := fh.Close(); err != nil {
+		_ = os.Remove(tempFile)
+		return fmt.Errorf("failed to write %s (%w)", file, err)
+	}
+	// Temporary files are only readable by the owner, use the permissions os.Create would have used.
+	if err := os.Chmod(tempFile, 0o644); err != nil { //nolint:gosec
+		_ = os.Remove(tempFile)
+		return fmt.Errorf("failed to set permissions of %s (%w)", file, err)
+	}
+	if err := os.Rename(tempFile, file); err != nil {
+		_ = os.Remove(tempFile)
+		return fmt.Errorf("failed to move the output to %s (%w)", file, err)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+// Package ovirttest provides an oVirt provider backed by a mock engine for testing Terraform modules from Go tests.
+//
+// A harness is created per test. Each harness has its own mock engine, which can be prepared and inspected through
+// Client(), and its provider factories can be passed to resource.Test in terraform-plugin-testing or in the
+// terraform-plugin-sdk:
+//
+//	h := ovirttest.New(t)
+//	resource.Test(t, resource.TestCase{
+//		ProtoV5ProviderFactories: h.ProtoV5ProviderFactories(),
+//		Steps: []resource.TestStep{
+//			{
+//				Config: config,
+//				Check: resource.ComposeTestCheckFunc(
+//					resource.TestCheckResourceAttrWith("ovirt_vm.test", "id", h.VMHasDisks(2)),
+//				),
+//			},
+//		},
+//	})
+//
+// The provider always runs against the mock engine, so the provider block of the tested configuration doesn't need to
+// set mock = true. Settings of the mock engine such as mock_fixtures and mock_faults can still be used.
+package ovirttest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v3"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+	"github.com/ovirt/terraform-provider-ovirt/v2/internal/ovirt"
+)
+
+// ProviderName is the name under which the provider factories register the oVirt provider.
+const ProviderName = "ovirt"
+
+// Harness is a mock oVirt engine together with the provider factories that use it.
+type Harness struct {
+	helper   ovirtclient.TestHelper
+	provider func() *schema.Provider
+}
+
+// New creates a harness with a fresh mock engine. The mock engine logs to the test log.
+func New(t *testing.T) *Harness {
+	helper, err := ovirtclient.NewMockTestHelper(ovirtclientlog.NewTestLogger(t))
+	if err != nil {
+		t.Fatalf("failed to create mock oVirt engine (%v)", err)
+	}
+	return &Harness{
+		helper:   helper,
+		provider: ovirt.NewMock(helper),
+	}
+}
+
+// ProviderFactories returns the provider factories for the ProviderFactories field of resource.TestCase in the
+// terraform-plugin-sdk.
+func (h *Harness) ProviderFactories() map[string]func() (*schema.Provider, error) {
+	return map[string]func() (*schema.Provider, error){
+		ProviderName: func() (*schema.Provider, error) { //nolint:unparam
+			return h.provider(), nil
+		},
+	}
+}
+
+// ProtoV5ProviderFactories returns the provider factories for the ProtoV5ProviderFactories field of resource.TestCase
+// in terraform-plugin-testing or the terraform-plugin-sdk. Unlike ProviderFactories, these also serve the provider
+// functions.
+func (h *Harness) ProtoV5ProviderFactories() map[string]func() (tfprotov5.ProviderServer, error) {
+	return map[string]func() (tfprotov5.ProviderServer, error){
+		ProviderName: func() (tfprotov5.ProviderServer, error) { //nolint:unparam
+			return ovirt.WithFunctions(schema.NewGRPCProviderServer(h.provider())), nil
+		},
+	}
+}
+
+// Client returns a client for the mock engine, which can be used to create fixtures before running Terraform or to
+// inspect the results afterwards.
+func (h *Harness) Client() ovirtclient.Client {
+	return h.helper.GetClient().WithContext(context.Background())
+}
+
+// TestHelper returns the test helper of the mock engine, which holds the IDs of the objects the mock engine is
+// created with, such as the cluster, the blank template and the storage domain.
+func (h *Harness) TestHelper() ovirtclient.TestHelper {
+	return h.helper
+}
+
+// VMExists returns a check that the VM with the ID passed to it exists. The check can be used with
+// resource.TestCheckResourceAttrWith on the id attribute of an ovirt_vm resource.
+func (h *Harness) VMExists() func(vmID string) error {
+	return func(vmID string) error {
+		if _, err := h.Client().GetVM(ovirtclient.VMID(vmID)); err != nil {
+			return fmt.Errorf("VM %s does not exist (%w)", vmID, err)
+		}
+		return nil
+	}
+}
+
+// VMHasDisks returns a check that the VM with the ID passed to it has exactly count disks attached.
+func (h *Harness) VMHasDisks(count int) func(vmID string) error {
+	return func(vmID string) error {
+		attachments, err := h.Client().ListDiskAttachments(ovirtclient.VMID(vmID))
+		if err != nil {
+			return fmt.Errorf("failed to list disk attachments of VM %s (%w)", vmID, err)
+		}
+		if len(attachments) != count {
+			return fmt.Errorf(
+				"incorrect number of disks on VM %s (expected: %d, got: %d)",
+				vmID,
+				count,
+				len(attachments),
+			)
+		}
+		return nil
+	}
+}
+
+// VMHasNICs returns a check that the VM with the ID passed to it has exactly count network interfaces.
+func (h *Harness) VMHasNICs(count int) func(vmID string) error {
+	return func(vmID string) error {
+		nics, err := h.Client().ListNICs(ovirtclient.VMID(vmID))
+		if err != nil {
+			return fmt.Errorf("failed to list NICs of VM %s (%w)", vmID, err)
+		}
+		if len(nics) != count {
+			return fmt.Errorf("incorrect number of NICs on VM %s (expected: %d, got: %d)", vmID, count, len(nics))
+		}
+		return nil
+	}
+}
+
+// VMHasTag returns a check that the tag with the specified name is attached to the VM with the ID passed to it.
+func (h *Harness) VMHasTag(tagName string) func(vmID string) error {
+	return func(vmID string) error {
+		tags, err := h.Client().ListVMTags(ovirtclient.VMID(vmID))
+		if err != nil {
+			return fmt.Errorf("failed to list tags of VM %s (%w)", vmID, err)
+		}
+		for _, tag := range tags {
+			if tag.Name() == tagName {
+				return nil
+			}
+		}
+		return fmt.Errorf("tag %s is not attached to VM %s", tagName, vmID)
+	}
+}
@@ -0,0 +1,104 @@
+package ovirttest_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+	"github.com/ovirt/terraform-provider-ovirt/v2/ovirttest"
+)
+
+func TestHarness(t *testing.T) {
+	t.Parallel()
+
+	h := ovirttest.New(t)
+	helper := h.TestHelper()
+	tagName := helper.GenerateTestResourceName(t)
+	tag, err := h.Client().CreateTag(tagName, nil)
+	if err != nil {
+		t.Fatalf("failed to create tag fixture (%v)", err)
+	}
+	config := fmt.Sprintf(
+		`
+resource "ovirt_vm" "test" {
+	cluster_id  = "%s"
+	template_id = "%s"
+	name        = "%s"
+}
+
+resource "ovirt_vm_tag" "test" {
+	vm_id  = ovirt_vm.test.id
+	tag_id = "%s"
+}
+`,
+		helper.GetClusterID(),
+		helper.GetBlankTemplateID(),
+		helper.GenerateTestResourceName(t),
+		tag.ID(),
+	)
+
+	resource.UnitTest(
+		t, resource.TestCase{
+			ProtoV5ProviderFactories: h.ProtoV5ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrWith("ovirt_vm.test", "id", h.VMExists()),
+						resource.TestCheckResourceAttrWith("ovirt_vm.test", "id", h.VMHasDisks(0)),
+						resource.TestCheckResourceAttrWith("ovirt_vm.test", "id", h.VMHasTag(tagName)),
+					),
+				},
+				{
+					Config:  config,
+					Destroy: true,
+				},
+			},
+		},
+	)
+}
+
+func TestHarnessChecks(t *testing.T) {
+	t.Parallel()
+
+	h := ovirttest.New(t)
+	helper := h.TestHelper()
+	client := h.Client()
+	vm, err := client.CreateVM(
+		helper.GetClusterID(),
+		helper.GetBlankTemplateID(),
+		helper.GenerateTestResourceName(t),
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("failed to create VM fixture (%v)", err)
+	}
+	disk, err := client.CreateDisk(helper.GetStorageDomainID(), ovirtclient.ImageFormatRaw, 1048576, nil)
+	if err != nil {
+		t.Fatalf("failed to create disk fixture (%v)", err)
+	}
+	if _, err := client.CreateDiskAttachment(vm.ID(), disk.ID(), ovirtclient.DiskInterfaceVirtIO, nil); err != nil {
+		t.Fatalf("failed to attach disk fixture (%v)", err)
+	}
+	vmID := string(vm.ID())
+
+	if err := h.VMExists()(vmID); err != nil {
+		t.Fatalf("existing VM not found (%v)", err)
+	}
+	if err := h.VMExists()("nonexistent"); err == nil {
+		t.Fatalf("nonexistent VM found")
+	}
+	if err := h.VMHasDisks(1)(vmID); err != nil {
+		t.Fatalf("disk count check failed (%v)", err)
+	}
+	if err := h.VMHasDisks(2)(vmID); err == nil {
+		t.Fatalf("disk count check passed with the wrong count")
+	}
+	if err := h.VMHasNICs(0)(vmID); err != nil {
+		t.Fatalf("NIC count check failed (%v)", err)
+	}
+	if err := h.VMHasTag("missing")(vmID); err == nil {
+		t.Fatalf("tag check passed for a tag that is not attached")
+	}
+}
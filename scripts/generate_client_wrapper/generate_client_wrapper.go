@@ -0,0 +1,155 @@
+//nolint:revive
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client/v3"
+)
+
+const ovirtClientPackage = "github.com/ovirt/go-ovirt-client/v3"
+
+// main generates the methods of wrappedClient for every ovirtclient.Client method that accepts retry strategies.
+// The remaining methods (GetURL, Reconnect, WithContext, GetContext) are written by hand.
+func main() {
+	source, err := generateClientWrapper()
+	if err != nil {
+		log.Fatalf("failed to generate client wrapper (%v)", err)
+	}
+	if err := os.WriteFile("internal/ovirt/client_wrapper_generated.go", source, 0o644); err != nil { //nolint:gosec
+		log.Fatalf("failed to write client wrapper (%v)", err)
+	}
+}
+
+func generateClientWrapper() ([]byte, error) {
+	clientType := reflect.TypeOf((*ovirtclient.Client)(nil)).Elem()
+	retryType := reflect.TypeOf((*ovirtclient.RetryStrategy)(nil)).Elem()
+	errorType := reflect.TypeOf((*error)(nil)).Elem()
+
+	imports := map[string]string{}
+	body := &bytes.Buffer{}
+	for i := 0; i < clientType.NumMethod(); i++ {
+		method := clientType.Method(i)
+		funcType := method.Type
+		if !funcType.IsVariadic() || funcType.In(funcType.NumIn()-1).Elem() != retryType {
+			continue
+		}
+		if funcType.NumOut() == 0 || funcType.Out(funcType.NumOut()-1) != errorType {
+			return nil, fmt.Errorf("method %s does not return an error as its last result", method.Name)
+		}
+
+		params := make([]string, 0, funcType.NumIn())
+		args := make([]string, 0, funcType.NumIn())
+		for j := 0; j < funcType.NumIn()-1; j++ {
+			params = append(params, fmt.Sprintf("p%d %s", j, typeName(funcType.In(j), imports)))
+			args = append(args, fmt.Sprintf("p%d", j))
+		}
+		params = append(params, "retries ..."+typeName(retryType, imports))
+		args = append(args, "retries...")
+
+		results := make([]string, 0, funcType.NumOut())
+		resultNames := make([]string, 0, funcType.NumOut())
+		for j := 0; j < funcType.NumOut()-1; j++ {
+			results = append(results, fmt.Sprintf("r%d %s", j, typeName(funcType.Out(j), imports)))
+			resultNames = append(resultNames, fmt.Sprintf("r%d", j))
+		}
+		results = append(results, "err error")
+		resultNames = append(resultNames, "err")
+
+		_, _ = fmt.Fprintf(
+			body,
+			`
+func (w *wrappedClient) %s(%s) (%s) {
+	err = w.call(%q, retries, func(retries []%s) error {
+		%s = w.client.%s(%s)
+		return err
+	})
+	return %s
+}
+`,
+			method.Name,
+			strings.Join(params, ", "),
+			strings.Join(results, ", "),
+			method.Name,
+			typeName(retryType, imports),
+			strings.Join(resultNames, ", "),
+			method.Name,
+			strings.Join(args, ", "),
+			strings.Join(resultNames, ", "),
+		)
+	}
+
+	importPaths := make([]string, 0, len(imports))
+	for importPath := range imports {
+		importPaths = append(importPaths, importPath)
+	}
+	sort.Slice(
+		importPaths, func(i, j int) bool {
+			iStd, jStd := isStandardPackage(importPaths[i]), isStandardPackage(importPaths[j])
+			if iStd != jStd {
+				return iStd
+			}
+			return importPaths[i] < importPaths[j]
+		},
+	)
+
+	source := &bytes.Buffer{}
+	source.WriteString("// Code generated by scripts/generate_client_wrapper. DO NOT EDIT.\n\npackage ovirt\n\nimport (\n")
+	for i, importPath := range importPaths {
+		if i > 0 && isStandardPackage(importPaths[i-1]) && !isStandardPackage(importPath) {
+			source.WriteString("\n")
+		}
+		if alias := imports[importPath]; alias != path.Base(importPath) {
+			_, _ = fmt.Fprintf(source, "\t%s %q\n", alias, importPath)
+		} else {
+			_, _ = fmt.Fprintf(source, "\t%q\n", importPath)
+		}
+	}
+	source.WriteString(")\n")
+	source.Write(body.Bytes())
+	return format.Source(source.Bytes())
+}
+
+func isStandardPackage(importPath string) bool {
+	return !strings.Contains(strings.Split(importPath, "/")[0], ".")
+}
+
+func typeName(t reflect.Type, imports map[string]string) string {
+	if t.Name() != "" {
+		if t.PkgPath() == "" {
+			return t.Name()
+		}
+		alias := path.Base(t.PkgPath())
+		if t.PkgPath() == ovirtClientPackage {
+			alias = "ovirtclient"
+		}
+		imports[t.PkgPath()] = alias
+		return alias + "." + t.Name()
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		return "*" + typeName(t.Elem(), imports)
+	case reflect.Slice:
+		return "[]" + typeName(t.Elem(), imports)
+	case reflect.Array:
+		return fmt.Sprintf("[%d]%s", t.Len(), typeName(t.Elem(), imports))
+	case reflect.Map:
+		return "map[" + typeName(t.Key(), imports) + "]" + typeName(t.Elem(), imports)
+	case reflect.Chan:
+		return t.ChanDir().String() + " " + typeName(t.Elem(), imports)
+	case reflect.Interface:
+		if t.NumMethod() == 0 {
+			return "interface{}"
+		}
+	}
+	log.Fatalf("unsupported type in client method signature: %s", t)
+	return ""
+}
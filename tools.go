@@ -10,3 +10,5 @@ import (
 //go:generate go run github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs
 
 //go:generate go run scripts/get_test_image/get_test_image.go
+
+//go:generate go run scripts/generate_client_wrapper/generate_client_wrapper.go